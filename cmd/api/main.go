@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gabapcia/gameblitz/internal/auth"
@@ -27,6 +28,8 @@ type Config struct {
 
 	PotgresDSN string `envconfig:"POSTGRESQL_DSN" required:"true"`
 
+	LeaderboardStorage string `envconfig:"LEADERBOARD_STORAGE" required:"false" default:"redis"` // Where the leaderboards definitions are stored: redis or postgres. Rankings are always stored on Redis
+
 	MongoURI string `envconfig:"MONGO_URI" required:"true"`
 	MongoDB  string `envconfig:"MONGO_DB" required:"true"`
 
@@ -42,6 +45,19 @@ type Config struct {
 	RabbitURI string `envconfig:"RABBITMQ_URI" required:"true"`
 }
 
+// Storage of the leaderboards definitions
+type leaderboardStorage interface {
+	CreateLeaderboard(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error)
+	GetLeaderboardByIDAndGameID(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error)
+	ListLeaderboards(ctx context.Context, gameID string, filter leaderboard.LeaderboardsFilter, page, limit int64) (leaderboard.Leaderboards, error)
+	ListGameLeaderboards(ctx context.Context, gameID string) ([]leaderboard.Leaderboard, error)
+	ListLeaderboardsByStatistic(ctx context.Context, gameID, statisticID string) ([]leaderboard.Leaderboard, error)
+	GetLeaderboardsByIDs(ctx context.Context, ids []string) ([]leaderboard.Leaderboard, error)
+	UpdateLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) (leaderboard.Leaderboard, error)
+	SoftDeleteLeaderboard(ctx context.Context, id, gameID string) error
+	RestoreLeaderboard(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error)
+}
+
 func main() {
 	zap.Start()
 	defer zap.Sync()
@@ -83,6 +99,29 @@ func main() {
 	}
 	defer postgres.Close()
 
+	var leaderboards leaderboardStorage
+	switch config.LeaderboardStorage {
+	case "redis":
+		leaderboards = redis
+	case "postgres":
+		leaderboards = postgres
+	default:
+		zap.Panic(fmt.Errorf("unknown leaderboard storage: %s", config.LeaderboardStorage), "env load failed")
+	}
+
+	upsertPlayerRankFunc := leaderboard.BuildUpsertPlayerRankFunc(rabbitmq.PlayerRankChanged, rabbitmq.PlayerDisplaced, redis.UpsertPlayerRankValue, redis.RecordRejections)
+	upsertStatisticPlayerRanksFunc := leaderboard.BuildUpsertStatisticPlayerRanksFunc(leaderboards.ListLeaderboardsByStatistic, upsertPlayerRankFunc)
+
+	// The player's statistic is already stored when its leaderboards are fed, so their failures are only logged.
+	// Failing the request would make the client retry an update that was already applied
+	feedStatisticLeaderboardsFunc := func(ctx context.Context, gameID, statisticID, playerID string, value float64) error {
+		if err := upsertStatisticPlayerRanksFunc(ctx, gameID, statisticID, playerID, value); err != nil {
+			zap.Error(err, "statistic leaderboards feed failed", "gameId", gameID, "statisticId", statisticID, "playerId", playerID)
+		}
+
+		return nil
+	}
+
 	restConfig := rest.Config{
 		Port: config.Port,
 
@@ -94,28 +133,52 @@ func main() {
 		AuthenticateFunc: auth.BuildAuthenticatorFunc(keycloack.Authenticate),
 
 		// Leaderboard
-		CreateLeaderboardFunc:              leaderboard.BuildCreateFunc(redis.CreateLeaderboard),
-		GetLeaderboardByIDAndGameIDFunc:    leaderboard.BuildGetByIDAndGameIDFunc(redis.GetLeaderboardByIDAndGameID),
-		DeleteLeaderboardByIDAndGameIDFunc: leaderboard.BuildSoftDeleteFunc(redis.SoftDeleteLeaderboard),
-
-		UpsertPlayerRankFunc: leaderboard.BuildUpsertPlayerRankFunc(redis.UpsertPlayerRankValue),
-		RankingFunc:          leaderboard.BuildRankingFunc(redis.GetRanking),
+		CreateLeaderboardFunc:              leaderboard.BuildCreateFunc(leaderboards.CreateLeaderboard, redis.ScheduleFinalization, mongo.StatisticExists),
+		ListLeaderboardsFunc:               leaderboard.BuildListFunc(leaderboards.ListLeaderboards),
+		UpdateLeaderboardFunc:              leaderboard.BuildUpdateFunc(leaderboards.GetLeaderboardByIDAndGameID, leaderboards.UpdateLeaderboard, redis.ScheduleFinalization, redis.CompleteFinalization, mongo.StatisticExists),
+		GetLeaderboardByIDAndGameIDFunc:    leaderboard.BuildGetByIDAndGameIDFunc(leaderboards.GetLeaderboardByIDAndGameID),
+		DeleteLeaderboardByIDAndGameIDFunc: leaderboard.BuildSoftDeleteFunc(leaderboards.SoftDeleteLeaderboard),
+		RestoreLeaderboardFunc:             leaderboard.BuildRestoreFunc(leaderboards.RestoreLeaderboard, redis.ScheduleFinalization),
+
+		UpsertPlayerRankFunc:              upsertPlayerRankFunc,
+		UpsertPlayerRanksFunc:             leaderboard.BuildUpsertPlayerRanksFunc(rabbitmq.PlayerRankChanged, rabbitmq.PlayerDisplaced, redis.UpsertPlayerRankValues, redis.RecordRejections),
+		UpsertPlayerLeaderboardsRanksFunc: leaderboard.BuildUpsertPlayerLeaderboardsRanksFunc(rabbitmq.PlayerRankChanged, rabbitmq.PlayerDisplaced, leaderboards.GetLeaderboardsByIDs, redis.UpsertPlayerLeaderboardsRankValues, redis.RecordRejections),
+		PlayerLeaderboardsRanksFunc:       leaderboard.BuildPlayerLeaderboardsRanksFunc(leaderboards.ListGameLeaderboards, redis.GetPlayerLeaderboardsRanks),
+		RejectionsFunc:                    leaderboard.BuildRejectionsFunc(redis.GetRejections),
+		RankingFunc:                       leaderboard.BuildRankingFunc(redis.GetRanking),
+		ExportRankingFunc:                 leaderboard.BuildExportRankingFunc(redis.StreamRanking),
+		PlayerRankFunc:                    leaderboard.BuildPlayerRankFunc(redis.GetPlayerRank),
+		PlayersRankingFunc:                leaderboard.BuildPlayersRankingFunc(redis.GetPlayersRanking),
+		ResultsFunc:                       leaderboard.BuildResultsFunc(mongo.GetLeaderboardResults),
+		ExportResultsFunc:                 leaderboard.BuildExportResultsFunc(mongo.GetLeaderboardResults, mongo.StreamLeaderboardResults),
+		PlayerRewardFunc:                  leaderboard.BuildPlayerRewardFunc(mongo.GetLeaderboardPlayerReward),
+		PlayerHistoryFunc:                 leaderboard.BuildPlayerHistoryFunc(redis.GetPlayerHistory),
+		RemovePlayerRankFunc:              leaderboard.BuildRemovePlayerRankFunc(redis.RemovePlayerRank),
+		BanPlayerFunc:                     leaderboard.BuildBanPlayerFunc(redis.BanPlayer, redis.RemovePlayerRank),
+		BansFunc:                          leaderboard.BuildBansFunc(redis.GetBans),
+		UnbanPlayerFunc:                   leaderboard.BuildUnbanPlayerFunc(redis.UnbanPlayer),
 
 		// Quest
 		CreateQuestFunc:           quest.BuildCreateQuestFunc(postgres.CreateQuest),
+		ListQuestsFunc:            quest.BuildListQuestsFunc(postgres.ListQuests),
 		GetQuestByIDAndGameIDFunc: quest.BuildGetQuestByIDAndGameIDFunc(postgres.GetQuestByIDAndGameID),
+		UpdateQuestFunc:           quest.BuildUpdateQuestFunc(postgres.UpdateQuest),
 		SoftDeleteQuestFunc:       quest.BuildSoftDeleteQuestFunc(postgres.SoftDeleteQuestByIDAndGameID),
+		RestoreQuestFunc:          quest.BuildRestoreQuestFunc(postgres.RestoreQuestByIDAndGameID),
 
 		StartQuestForPlayerFunc:          quest.BuildStartQuestForPlayerFunc(postgres.StartQuestForPlayer),
+		ListPlayerQuestsFunc:             quest.BuildListPlayerQuestsFunc(postgres.ListPlayerQuests),
 		GetPlayerQuestProgressionFunc:    quest.BuildGetPlayerQuestProgression(postgres.GetPlayerQuestProgression),
 		UpdatePlayerQuestProgressionFunc: quest.BuildUpdatePlayerQuestProgressionFunc(rabbitmq.PlayerQuestProgressionUpdates, postgres.GetPlayerQuestProgression, postgres.UpdatePlayerQuestProgression),
+		MigratePlayerQuestFunc:           quest.BuildMigratePlayerQuestFunc(rabbitmq.PlayerQuestProgressionUpdates, postgres.GetPlayerQuestProgression, postgres.MigratePlayerQuest),
 
 		// Statistic
 		CreateStatisticFunc:                  statistic.BuildCreateStatisticFunc(mongo.CreateStatistic),
 		GetStatisticByIDAndGameIDFunc:        statistic.BuildGetStatisticByIDAndGameID(mongo.GetStatisticByIDAndGameID),
 		SoftDeleteStatisticByIDAndGameIDFunc: statistic.BuildSoftDeleteStatistic(mongo.SoftDeleteStatistic),
+		RestoreStatisticByIDAndGameIDFunc:    statistic.BuildRestoreStatistic(mongo.RestoreStatistic),
 
-		UpsertPlayerStatisticProgressionFunc: statistic.BuildUpsertPlayerProgressionFunc(rabbitmq.PlayerStatisticProgressionUpdates, mongo.UpdatePlayerStatisticProgression),
+		UpsertPlayerStatisticProgressionFunc: statistic.BuildUpsertPlayerProgressionFunc(rabbitmq.PlayerStatisticProgressionUpdates, feedStatisticLeaderboardsFunc, mongo.UpdatePlayerStatisticProgression),
 		GetPlayerStatisticProgressionFunc:    statistic.BuildGetPlayerProgression(mongo.GetPlayerProgression),
 	}
 	if err := rest.Execute(restConfig); err != nil {
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/infra/async/rabbitmq"
+	"github.com/gabapcia/gameblitz/internal/infra/logger/zap"
+	"github.com/gabapcia/gameblitz/internal/infra/storage/mongo"
+	"github.com/gabapcia/gameblitz/internal/infra/storage/postgres"
+	"github.com/gabapcia/gameblitz/internal/infra/storage/redis"
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+	"github.com/gabapcia/gameblitz/internal/quest"
+	"github.com/gabapcia/gameblitz/internal/statistic"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	PotgresDSN string `envconfig:"POSTGRESQL_DSN" required:"true"`
+
+	LeaderboardStorage string `envconfig:"LEADERBOARD_STORAGE" required:"false" default:"redis"` // Where the leaderboards definitions are stored: redis or postgres. Rankings are always stored on Redis
+
+	MongoURI string `envconfig:"MONGO_URI" required:"true"`
+	MongoDB  string `envconfig:"MONGO_DB" required:"true"`
+
+	RedisAddr     string `envconfig:"REDIS_ADDR" required:"true"`
+	RedisUsername string `envconfig:"REDIS_USERNAME" required:"false"`
+	RedisPassword string `envconfig:"REDIS_PASSWORD" required:"false"`
+	RedisDB       int    `envconfig:"REDIS_DB" required:"false"`
+
+	RabbitURI string `envconfig:"RABBITMQ_URI" required:"true"`
+
+	FinalizationInterval int  `envconfig:"FINALIZATION_INTERVAL" required:"false" default:"60"`
+	PurgeInterval        int  `envconfig:"PURGE_INTERVAL" required:"false" default:"3600"`
+	DeletedRetention     uint `envconfig:"DELETED_RETENTION" required:"false" default:"30"` // Days that deleted data is kept before being purged
+}
+
+// Storage of the leaderboards definitions
+type leaderboardStorage interface {
+	GetLeaderboardsByIDs(ctx context.Context, ids []string) ([]leaderboard.Leaderboard, error)
+	ListDeletedLeaderboards(ctx context.Context, deletedBefore time.Time, limit int64) ([]leaderboard.Leaderboard, error)
+	PurgeLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) error
+}
+
+// Runs the job every interval until the context is done
+func schedule(ctx context.Context, interval time.Duration, name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil {
+			zap.Error(err, "job failed", "job", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func main() {
+	zap.Start()
+	defer zap.Sync()
+
+	var config Config
+	if err := envconfig.Process("", &config); err != nil {
+		zap.Panic(err, "env load failed")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	redis := redis.New(ctx, config.RedisAddr, config.RedisUsername, config.RedisPassword, config.RedisDB)
+	defer redis.Close()
+
+	rabbitmq, err := rabbitmq.NewProducer(ctx, config.RabbitURI)
+	if err != nil {
+		zap.Panic(err, "rabbitmq startup failed")
+	}
+	defer rabbitmq.Close()
+
+	mongo, err := mongo.New(ctx, config.MongoURI, config.MongoDB)
+	if err != nil {
+		zap.Panic(err, "mongo startup failed")
+	}
+	defer mongo.Close(context.Background())
+
+	postgres, err := postgres.New(ctx, config.PotgresDSN)
+	if err != nil {
+		zap.Panic(err, "postgres startup failed")
+	}
+	defer postgres.Close()
+
+	var leaderboards leaderboardStorage
+	switch config.LeaderboardStorage {
+	case "redis":
+		if err := redis.IndexLeaderboards(ctx); err != nil {
+			zap.Panic(err, "leaderboards indexing failed")
+		}
+		leaderboards = redis
+	case "postgres":
+		leaderboards = postgres
+	default:
+		zap.Panic(fmt.Errorf("unknown leaderboard storage: %s", config.LeaderboardStorage), "env load failed")
+	}
+
+	finalizeDueLeaderboardsFunc := leaderboard.BuildFinalizeDueFunc(
+		rabbitmq.LeaderboardFinalized,
+		rabbitmq.PlayerRewarded,
+		redis.ClaimDueFinalizations,
+		redis.RenewFinalization,
+		leaderboards.GetLeaderboardsByIDs,
+		redis.ScheduleFinalization,
+		redis.CompleteFinalization,
+		redis.GetRanking,
+		mongo.AppendLeaderboardResults,
+		mongo.SaveLeaderboardRewards,
+		mongo.SaveLeaderboardResults,
+	)
+
+	var (
+		purgeDeletedLeaderboardsFunc = leaderboard.BuildPurgeDeletedFunc(leaderboards.ListDeletedLeaderboards, mongo.PurgeLeaderboardResults, redis.PurgeRankings, leaderboards.PurgeLeaderboard)
+		purgeDeletedQuestsFunc       = quest.BuildPurgeDeletedQuestsFunc(postgres.PurgeDeletedQuests)
+		purgeDeletedStatisticsFunc   = statistic.BuildPurgeDeletedStatistics(mongo.PurgeDeletedStatistics)
+		retention                    = time.Duration(config.DeletedRetention) * 24 * time.Hour
+	)
+
+	purgeDeletedFunc := func(ctx context.Context) error {
+		return errors.Join(
+			purgeDeletedLeaderboardsFunc(ctx, retention),
+			purgeDeletedQuestsFunc(ctx, retention),
+			purgeDeletedStatisticsFunc(ctx, retention),
+		)
+	}
+
+	go schedule(ctx, time.Duration(config.PurgeInterval)*time.Second, "deleted data purge", purgeDeletedFunc)
+	schedule(ctx, time.Duration(config.FinalizationInterval)*time.Second, "leaderboard finalization", finalizeDueLeaderboardsFunc)
+}
@@ -0,0 +1,135 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BanPlayerReq struct {
+	Reason string `json:"reason"` // Why the player is being banned
+}
+
+type Ban struct {
+	PlayerID string    `json:"playerId"` // Player's ID
+	Reason   string    `json:"reason"`   // Why the player was banned
+	BannedAt time.Time `json:"bannedAt"` // Time that the player was banned
+}
+
+func banFromDomain(b leaderboard.Ban) Ban {
+	return Ban{
+		PlayerID: b.PlayerID,
+		Reason:   b.Reason,
+		BannedAt: b.BannedAt,
+	}
+}
+
+type Bans struct {
+	Total int64 `json:"total"` // Number of players banned from the leaderboard
+	Bans  []Ban `json:"bans"`  // Bans page, most recent first
+}
+
+func bansFromDomain(b leaderboard.Bans) Bans {
+	bans := make([]Ban, len(b.Bans))
+	for i, ban := range b.Bans {
+		bans[i] = banFromDomain(ban)
+	}
+
+	return Bans{
+		Total: b.Total,
+		Bans:  bans,
+	}
+}
+
+var (
+	ErrorResponsePlayerBanned     = ErrorResponse{Code: "2.12", Message: "player banned"}
+	ErrorResponseBanNotFound      = ErrorResponse{Code: "2.13", Message: "ban not found"}
+	ErrorResponseBanInvalidReason = ErrorResponse{Code: "2.14", Message: "invalid ban reason"}
+)
+
+// @summary Ban Player
+// @description Ban a player from the leaderboard. The player's entry is removed from the current ranking and its future rank updates are rejected
+// @router /api/v1/leaderboards/{leaderboardId}/bans/{playerId} [POST]
+// @accept json
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @param BanPlayerData body BanPlayerReq false "Ban details"
+// @success 201 {object} Ban
+// @failure 400,404,422,500 {object} ErrorResponse
+func buildBanPlayerHandler(banPlayerFunc leaderboard.BanPlayerFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+		)
+
+		var body BanPlayerReq
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&body); err != nil {
+				return err
+			}
+		}
+
+		ban, err := banPlayerFunc(c.Context(), leaderboard, playerID, body.Reason)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusCreated).JSON(banFromDomain(ban))
+	}
+}
+
+// @summary Leaderboard Bans
+// @description List the players banned from the leaderboard paginated, most recent first
+// @router /api/v1/leaderboards/{leaderboardId}/bans [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param page query int false "Page number" minimun(0) default(0)
+// @param limit query int false "Number of bans per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} Bans
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetBansHandler(bansFunc leaderboard.BansFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			page        = c.QueryInt("page", 0)
+			limit       = c.QueryInt("limit", 10)
+		)
+
+		bans, err := bansFunc(c.Context(), leaderboard, int64(page), int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(bansFromDomain(bans))
+	}
+}
+
+// @summary Unban Player
+// @description Lift the player's ban from the leaderboard
+// @router /api/v1/leaderboards/{leaderboardId}/bans/{playerId} [DELETE]
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @success 204
+// @failure 404,500 {object} ErrorResponse
+func buildUnbanPlayerHandler(unbanPlayerFunc leaderboard.UnbanPlayerFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+		)
+
+		if err := unbanPlayerFunc(c.Context(), leaderboard, playerID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
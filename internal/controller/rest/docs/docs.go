@@ -19,6 +19,74 @@ const docTemplate = `{
     "basePath": "{{.BasePath}}",
     "paths": {
         "/api/v1/leaderboards": {
+            "get": {
+                "description": "List the game's leaderboards paginated, most recent first. Leaderboards that didn't start yet are active",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List Leaderboards",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ACTIVE",
+                            "CLOSED",
+                            "DELETED"
+                        ],
+                        "type": "string",
+                        "description": "Leaderboard status. Lists every leaderboard that's not deleted when empty",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text that the leaderboard name must contain, ignoring the case",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of leaderboards per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Leaderboards"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
                 "description": "Create a leaderboard",
                 "consumes": [
@@ -166,15 +234,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/leaderboards/{leaderboardId}/ranking": {
-            "get": {
-                "description": "Get the leaderboard ranking paginated",
+            },
+            "patch": {
+                "description": "Update the leaderboard name, description and end time. Changing the end time reschedules the finalization of the period in progress",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Leaderboard Ranking",
+                "summary": "Update Leaderboard",
                 "parameters": [
                     {
                         "type": "string",
@@ -191,29 +260,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 500,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of rankings per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Leaderboard fields to update",
+                        "name": "UpdateLeaderboardData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.UpdateLeaderboardReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/rest.Rank"
-                            }
+                            "$ref": "#/definitions/rest.Leaderboard"
                         }
                     },
                     "400": {
@@ -243,16 +303,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/leaderboards/{leaderboardId}/ranking/{playerId}": {
-            "post": {
-                "description": "Set or update a player's rank on the leaderboard",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/leaderboards/{leaderboardId}/bans": {
+            "get": {
+                "description": "List the players banned from the leaderboard paginated, most recent first",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Upsert Player Rank",
+                "summary": "Leaderboard Bans",
                 "parameters": [
                     {
                         "type": "string",
@@ -269,30 +326,26 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Player ID",
-                        "name": "playerId",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "Values to update the player rank",
-                        "name": "UpsertPlayerRankData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.UpsertPlayerRankReq"
-                        }
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of bans per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/rest.Bans"
                         }
                     },
                     "404": {
@@ -316,16 +369,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/quests": {
+        "/api/v1/leaderboards/{leaderboardId}/bans/{playerId}": {
             "post": {
-                "description": "Create a quest and its tasks",
+                "description": "Ban a player from the leaderboard. The player's entry is removed from the current ranking and its future rank updates are rejected",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Create Quest",
+                "summary": "Ban Player",
                 "parameters": [
                     {
                         "type": "string",
@@ -335,12 +388,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "New quest config data",
-                        "name": "NewQuestData",
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ban details",
+                        "name": "BanPlayerData",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.CreateQuestReq"
+                            "$ref": "#/definitions/rest.BanPlayerReq"
                         }
                     }
                 ],
@@ -348,7 +414,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/rest.Quest"
+                            "$ref": "#/definitions/rest.Ban"
                         }
                     },
                     "400": {
@@ -357,51 +423,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/quests/{questId}": {
-            "get": {
-                "description": "Get a quest and its tasks",
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Get Quest By ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Game's JWT authorization",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Quest ID",
-                        "name": "questId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/rest.Quest"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -423,8 +444,8 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete a quest and its tasks",
-                "summary": "Delete Quest",
+                "description": "Lift the player's ban from the leaderboard",
+                "summary": "Unban Player",
                 "parameters": [
                     {
                         "type": "string",
@@ -435,8 +456,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Quest ID",
-                        "name": "questId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
                         "in": "path",
                         "required": true
                     }
@@ -451,12 +479,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -466,13 +488,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/quests/{questId}/players/{playerId}": {
+        "/api/v1/leaderboards/{leaderboardId}/ranking": {
             "get": {
-                "description": "Get a player's quest progression",
+                "description": "Get the leaderboard ranking paginated",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get Player Quest Progression",
+                "summary": "Leaderboard Ranking",
                 "parameters": [
                     {
                         "type": "string",
@@ -483,24 +505,50 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Quest ID",
-                        "name": "questId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Player ID",
-                        "name": "playerId",
-                        "in": "path",
-                        "required": true
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the current one",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope partition formatted as ` + "`" + `{dimension}:{value}` + "`" + `, like ` + "`" + `region:eu` + "`" + `. Defaults to the global ranking",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of rankings per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                            "$ref": "#/definitions/rest.Ranking"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "404": {
@@ -524,11 +572,14 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Start a player's quest progression",
+                "description": "Set or update the rank of many players at once, like the results of a match. Entries are applied independently and the ones that fail are reported",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Start Player Quest Progression",
+                "summary": "Upsert Player Ranks",
                 "parameters": [
                     {
                         "type": "string",
@@ -539,34 +590,39 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Quest ID",
-                        "name": "questId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Player ID",
-                        "name": "playerId",
-                        "in": "path",
-                        "required": true
+                        "description": "Values to update the players rank (up to 500)",
+                        "name": "UpsertPlayerRanksData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rest.UpsertPlayerRanksEntryReq"
+                            }
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                            "$ref": "#/definitions/rest.UpsertPlayerRanksResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -584,16 +640,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Updates a player's quest progression",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/ranking/export": {
+            "get": {
+                "description": "Stream the whole leaderboard ranking as CSV or NDJSON. The ranking isn't frozen while it's exported, so players that change their positions meanwhile may be exported twice or skipped",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
-                "summary": "Update Player Quest Progression",
+                "summary": "Export Leaderboard Ranking",
                 "parameters": [
                     {
                         "type": "string",
@@ -604,33 +660,40 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Quest ID",
-                        "name": "questId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
                         "in": "path",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
                         "type": "string",
-                        "description": "Player ID",
-                        "name": "playerId",
-                        "in": "path",
-                        "required": true
+                        "default": "csv",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
                     },
                     {
-                        "description": "Player data to check",
-                        "name": "ProgressData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.UpdatePlayerQuestProgressionReq"
-                        }
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the current one",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope partition formatted as ` + "`" + `{dimension}:{value}` + "`" + `, like ` + "`" + `region:eu` + "`" + `. Defaults to the global ranking",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                            "type": "file"
                         }
                     },
                     "404": {
@@ -654,16 +717,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/statistics": {
-            "post": {
-                "description": "Create a statistic",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/leaderboards/{leaderboardId}/ranking/players": {
+            "get": {
+                "description": "Get the ranking of a set of players, like a player's friends, with their global and relative positions. Players that are not ranked are skipped",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Create Statistic",
+                "summary": "Players Ranking",
                 "parameters": [
                     {
                         "type": "string",
@@ -673,24 +733,41 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "New statistic config data",
-                        "name": "NewStatisticData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.CreateStatisticReq"
-                        }
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated player IDs (up to 100)",
+                        "name": "playerIds",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the current one",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope partition formatted as ` + "`" + `{dimension}:{value}` + "`" + `, like ` + "`" + `region:eu` + "`" + `. Defaults to the global ranking",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.Statistic"
+                            "$ref": "#/definitions/rest.PlayersRanking"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -710,13 +787,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/statistics/{statisticId}": {
+        "/api/v1/leaderboards/{leaderboardId}/ranking/{playerId}": {
             "get": {
-                "description": "Get a statistic by its id",
+                "description": "Get the player's rank and the players ranked right above and below it",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get Statistic By ID",
+                "summary": "Player Rank",
                 "parameters": [
                     {
                         "type": "string",
@@ -727,17 +804,44 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Statistic ID",
-                        "name": "statisticId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the current one",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scope partition formatted as ` + "`" + `{dimension}:{value}` + "`" + `, like ` + "`" + `region:eu` + "`" + `. Defaults to the global ranking",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of players to return above and below the player",
+                        "name": "around",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.Statistic"
+                            "$ref": "#/definitions/rest.PlayerRank"
                         }
                     },
                     "404": {
@@ -760,9 +864,15 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a statistic by its id",
-                "summary": "Delete Statistic",
+            "post": {
+                "description": "Set or update a player's rank on the leaderboard",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Upsert Player Rank",
                 "parameters": [
                     {
                         "type": "string",
@@ -773,16 +883,38 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Statistic ID",
-                        "name": "statisticId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Values to update the player rank",
+                        "name": "UpsertPlayerRankData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.UpsertPlayerRankReq"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -795,6 +927,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -802,15 +940,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/statistics/{statisticId}/players/{playerId}": {
-            "get": {
-                "description": "Get the player's statistic progression",
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Get Player Statistic Progression By ID",
+            },
+            "delete": {
+                "description": "Remove the player's entry from the current ranking, including its scope partitions",
+                "summary": "Remove Player Rank",
                 "parameters": [
                     {
                         "type": "string",
@@ -821,24 +954,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Statistic ID",
-                        "name": "statisticId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/rest.PlayerStatisticProgression"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "404": {
                         "description": "Not Found",
@@ -846,12 +977,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -859,16 +984,15 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Set or update a player's statistic progression",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/ranking/{playerId}/history": {
+            "get": {
+                "description": "Get every submission made to the player's rank paginated, most recent first",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Upsert Player Statistic Progression",
+                "summary": "Player Rank History",
                 "parameters": [
                     {
                         "type": "string",
@@ -879,8 +1003,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Statistic ID",
-                        "name": "statisticId",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
                         "in": "path",
                         "required": true
                     },
@@ -892,23 +1016,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Values to update the player statistic progression",
-                        "name": "UpsertPlayerStatisticData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.UpsertPlayerStatisticProgressionReq"
-                        }
+                        "type": "string",
+                        "description": "Cursor returned by the previous page. Starts from the most recent submission when empty",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of submissions per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/rest.PlayerHistory"
                         }
                     },
                     "404": {
@@ -931,9 +1057,1468 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
+        },
+        "/api/v1/leaderboards/{leaderboardId}/rejections": {
+            "get": {
+                "description": "Get the submissions rejected by the leaderboard rules paginated, most recent first. Only recorded when the leaderboard rules ask for it",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Leaderboard Rejections",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page. Starts from the most recent rejection when empty",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of rejections per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Rejections"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/restore": {
+            "post": {
+                "description": "Restore a deleted leaderboard by id and game id. Leaderboards are permanently removed once the deletion retention period is over",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Restore Leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Leaderboard"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/results": {
+            "get": {
+                "description": "Get the final ranking of a closed leaderboard period paginated",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Leaderboard Results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the last one closed",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of rankings per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Results"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/results/export": {
+            "get": {
+                "description": "Stream the whole final ranking of a closed leaderboard period as CSV or NDJSON",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "summary": "Export Leaderboard Results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the last one closed",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/leaderboards/{leaderboardId}/rewards/{playerId}": {
+            "get": {
+                "description": "Get the reward tier earned by the player when a leaderboard period closed",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Player Reward",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Leaderboard ID",
+                        "name": "leaderboardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period ID (` + "`" + `2006-01-02` + "`" + `, ` + "`" + `2006-W01` + "`" + ` or ` + "`" + `2006-01` + "`" + ` for daily, weekly and monthly leaderboards). Defaults to the last one closed",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Reward"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{playerId}/quests": {
+            "get": {
+                "description": "List the game's quests started by the player paginated, most recently started first, with how many of their tasks were completed. Deleted quests are skipped",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List Player Quests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of quests per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerQuests"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/players/{playerId}/rankings": {
+            "get": {
+                "description": "Get the player's rank on every leaderboard of the game. Deleted leaderboards and the ones that don't rank the player are skipped",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Player Leaderboards Ranks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerLeaderboardsRanks"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Set or update the player's rank on many leaderboards at once, like the ones updated by a single match. Either every rank is updated or none is",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Upsert Player Leaderboards Ranks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Values to update the player rank, by leaderboard ID (up to 20)",
+                        "name": "UpsertPlayerLeaderboardsRanksData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.UpsertPlayerLeaderboardsRanksReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests": {
+            "get": {
+                "description": "List the game's quests paginated, most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List Quests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "List the deleted quests instead of the ones that are not deleted",
+                        "name": "deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text that the quest name must contain, ignoring the case",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Lists the quests created at or after this time, as RFC 3339",
+                        "name": "createdAfter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Lists the quests created before this time, as RFC 3339",
+                        "name": "createdBefore",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 500,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of quests per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Quests"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a quest and its tasks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "New quest config data",
+                        "name": "NewQuestData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.CreateQuestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/{questId}": {
+            "get": {
+                "description": "Get a quest and its tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get Quest By ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create a new version of the quest with the given config data. Players that already started the quest keep progressing on their version until they're migrated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quest config data",
+                        "name": "QuestData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.CreateQuestReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a quest and its tasks",
+                "summary": "Delete Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/{questId}/players/{playerId}": {
+            "get": {
+                "description": "Get a player's quest progression",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get Player Quest Progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start a player's quest progression",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Start Player Quest Progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates a player's quest progression",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update Player Quest Progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player data to check",
+                        "name": "ProgressData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.UpdatePlayerQuestProgressionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/{questId}/players/{playerId}/migrate": {
+            "post": {
+                "description": "Move a player's quest progression to the latest quest version. Tasks keep their progression by their key",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Migrate Player Quest Progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerQuestProgression"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/{questId}/restore": {
+            "post": {
+                "description": "Restore a deleted quest and the tasks deleted with it. Quests are permanently removed once the deletion retention period is over",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Restore Quest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quest ID",
+                        "name": "questId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/statistics": {
+            "post": {
+                "description": "Create a statistic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create Statistic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "New statistic config data",
+                        "name": "NewStatisticData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.CreateStatisticReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Statistic"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/statistics/{statisticId}": {
+            "get": {
+                "description": "Get a statistic by its id",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get Statistic By ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statistic ID",
+                        "name": "statisticId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Statistic"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a statistic by its id",
+                "summary": "Delete Statistic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statistic ID",
+                        "name": "statisticId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/statistics/{statisticId}/players/{playerId}": {
+            "get": {
+                "description": "Get the player's statistic progression",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get Player Statistic Progression By ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statistic ID",
+                        "name": "statisticId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PlayerStatisticProgression"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Set or update a player's statistic progression",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Upsert Player Statistic Progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statistic ID",
+                        "name": "statisticId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Player ID",
+                        "name": "playerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Values to update the player statistic progression",
+                        "name": "UpsertPlayerStatisticData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.UpsertPlayerStatisticProgressionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/statistics/{statisticId}/restore": {
+            "post": {
+                "description": "Restore a deleted statistic by its id. Statistics are permanently removed once the deletion retention period is over",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Restore Statistic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Game's JWT authorization",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Statistic ID",
+                        "name": "statisticId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.Statistic"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
+        "rest.Ban": {
+            "type": "object",
+            "properties": {
+                "bannedAt": {
+                    "description": "Time that the player was banned",
+                    "type": "string"
+                },
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Why the player was banned",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.BanPlayerReq": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Why the player is being banned",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.Bans": {
+            "type": "object",
+            "properties": {
+                "bans": {
+                    "description": "Bans page, most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Ban"
+                    }
+                },
+                "total": {
+                    "description": "Number of players banned from the leaderboard",
+                    "type": "integer"
+                }
+            }
+        },
         "rest.CreateLeaderboardReq": {
             "type": "object",
             "properties": {
@@ -941,373 +2526,968 @@ const docTemplate = `{
                     "description": "Data aggregation mode",
                     "type": "string",
                     "enum": [
-                        "INC",
+                        "INC",
+                        "MAX",
+                        "MIN",
+                        "LAST",
+                        "AVG",
+                        "COUNT"
+                    ]
+                },
+                "description": {
+                    "description": "Leaderboard's description",
+                    "type": "string"
+                },
+                "endAt": {
+                    "description": "Time that the leaderboard will be closed for new updates",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Leaderboard's name",
+                    "type": "string"
+                },
+                "notifyTop": {
+                    "description": "Players displaced out of this top are notified. Zero when nobody is notified",
+                    "type": "integer"
+                },
+                "ordering": {
+                    "description": "Leaderboard ranking order",
+                    "type": "string",
+                    "enum": [
+                        "ASC",
+                        "DESC"
+                    ]
+                },
+                "rankingMode": {
+                    "description": "How tied players are positioned on the ranking. Defaults to ` + "`" + `ORDINAL` + "`" + `",
+                    "type": "string",
+                    "enum": [
+                        "STANDARD",
+                        "DENSE",
+                        "ORDINAL"
+                    ]
+                },
+                "recurrence": {
+                    "description": "How often the ranking resets. Defaults to ` + "`" + `NONE` + "`" + `",
+                    "type": "string",
+                    "enum": [
+                        "NONE",
+                        "DAILY",
+                        "WEEKLY",
+                        "MONTHLY"
+                    ]
+                },
+                "rewardTiers": {
+                    "description": "Tiers awarded to the players when each period closes. The first tier that awards a player wins",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.RewardTier"
+                    }
+                },
+                "rollingWindowHours": {
+                    "description": "Only the submissions made on the last hours count towards the ranking, and no rank change is notified. Zero when every submission counts",
+                    "type": "integer"
+                },
+                "scopes": {
+                    "description": "Dimensions that partition the ranking",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "region",
+                        "platform"
+                    ]
+                },
+                "startAt": {
+                    "description": "Time that the leaderboard should start working",
+                    "type": "string"
+                },
+                "statisticId": {
+                    "description": "Statistic whose player values feed the ranking. Only ` + "`" + `LAST` + "`" + `, ` + "`" + `MAX` + "`" + ` and ` + "`" + `MIN` + "`" + ` leaderboards can be fed by a statistic",
+                    "type": "string"
+                },
+                "submissionRules": {
+                    "description": "Rules that every submission must follow to be applied",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rest.SubmissionRules"
+                        }
+                    ]
+                },
+                "tieBreakPolicy": {
+                    "description": "How tied players are ordered on the ranking. Defaults to ` + "`" + `NONE` + "`" + `",
+                    "type": "string",
+                    "enum": [
+                        "EARLIEST",
+                        "LATEST",
+                        "NONE"
+                    ]
+                },
+                "tiers": {
+                    "description": "Tiers that the players are placed on, like bronze, silver and gold. The first tier that places a player wins",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Tier"
+                    }
+                },
+                "timezone": {
+                    "description": "IANA timezone used to calculate the ranking periods. Defaults to ` + "`" + `UTC` + "`" + `",
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                }
+            }
+        },
+        "rest.CreateQuestReq": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Quest details",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Quest name",
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "Quest task list",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "dependsOn": {
+                                "description": "List of array indexes of the tasks that needs to be completed before this one can be started",
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            },
+                            "description": {
+                                "description": "Task details",
+                                "type": "string"
+                            },
+                            "key": {
+                                "description": "Task key that identifies it across the quest versions. Defaults to the task array index",
+                                "type": "string"
+                            },
+                            "name": {
+                                "description": "Task name",
+                                "type": "string"
+                            },
+                            "requiredForCompletion": {
+                                "description": "Is this task required for the quest completion? Defaults to ` + "`" + `true` + "`" + `",
+                                "type": "boolean"
+                            },
+                            "rule": {
+                                "description": "Task completion logic as JsonLogic. See https://jsonlogic.com/",
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "tasksValidators": {
+                    "description": "Quest task list success validation data",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "rest.CreateStatisticReq": {
+            "type": "object",
+            "properties": {
+                "aggregationMode": {
+                    "description": "Data aggregation mode",
+                    "type": "string",
+                    "enum": [
+                        "SUM",
+                        "SUB",
                         "MAX",
                         "MIN"
                     ]
                 },
+                "description": {
+                    "description": "Statistic details",
+                    "type": "string"
+                },
+                "goal": {
+                    "description": "Goal value. nil means no goal",
+                    "type": "number"
+                },
+                "initialValue": {
+                    "description": "Initial statistic value for players. Defaults to zero on ` + "`" + `'aggregationMode' in ['SUM', 'SUB']` + "`" + `",
+                    "type": "number"
+                },
+                "landmarks": {
+                    "description": "Statistic landmarks",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "name": {
+                    "description": "Statistic name",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Error unique code",
+                    "type": "string"
+                },
+                "details": {
+                    "description": "Details about the source of the error",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "description": "Error message",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.Leaderboard": {
+            "type": "object",
+            "properties": {
+                "aggregationMode": {
+                    "description": "Data aggregation mode",
+                    "type": "string",
+                    "enum": [
+                        "INC",
+                        "MAX",
+                        "MIN",
+                        "LAST",
+                        "AVG",
+                        "COUNT"
+                    ]
+                },
+                "createdAt": {
+                    "description": "Time that the leaderboard was created",
+                    "type": "string"
+                },
                 "description": {
                     "description": "Leaderboard's description",
                     "type": "string"
                 },
-                "endAt": {
-                    "description": "Time that the leaderboard will be closed for new updates",
+                "endAt": {
+                    "description": "Time that the leaderboard will be closed for new updates",
+                    "type": "string"
+                },
+                "gameId": {
+                    "description": "The ID from the game that is responsible for the leaderboard",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Leaderboard's ID",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Leaderboard's name",
+                    "type": "string"
+                },
+                "notifyTop": {
+                    "description": "Players displaced out of this top are notified. Zero when nobody is notified",
+                    "type": "integer"
+                },
+                "ordering": {
+                    "description": "Leaderboard ranking order",
+                    "type": "string",
+                    "enum": [
+                        "ASC",
+                        "DESC"
+                    ]
+                },
+                "rankingMode": {
+                    "description": "How tied players are positioned on the ranking",
+                    "type": "string",
+                    "enum": [
+                        "STANDARD",
+                        "DENSE",
+                        "ORDINAL"
+                    ]
+                },
+                "recurrence": {
+                    "description": "How often the ranking resets",
+                    "type": "string",
+                    "enum": [
+                        "NONE",
+                        "DAILY",
+                        "WEEKLY",
+                        "MONTHLY"
+                    ]
+                },
+                "rewardTiers": {
+                    "description": "Tiers awarded to the players when each period closes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.RewardTier"
+                    }
+                },
+                "rollingWindowHours": {
+                    "description": "Only the submissions made on the last hours count towards the ranking, and no rank change is notified. Zero when every submission counts",
+                    "type": "integer"
+                },
+                "scopes": {
+                    "description": "Dimensions that partition the ranking",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startAt": {
+                    "description": "Time that the leaderboard should start working",
+                    "type": "string"
+                },
+                "statisticId": {
+                    "description": "Statistic whose player values feed the ranking. Only ` + "`" + `LAST` + "`" + `, ` + "`" + `MAX` + "`" + ` and ` + "`" + `MIN` + "`" + ` leaderboards can be fed by a statistic",
+                    "type": "string"
+                },
+                "submissionRules": {
+                    "description": "Rules that every submission must follow to be applied",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rest.SubmissionRules"
+                        }
+                    ]
+                },
+                "tieBreakPolicy": {
+                    "description": "How tied players are ordered on the ranking",
+                    "type": "string",
+                    "enum": [
+                        "EARLIEST",
+                        "LATEST",
+                        "NONE"
+                    ]
+                },
+                "tiers": {
+                    "description": "Tiers that the players are placed on, like bronze, silver and gold",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Tier"
+                    }
+                },
+                "timezone": {
+                    "description": "IANA timezone used to calculate the ranking periods",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Last time that the leaderboard info was updated",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.LeaderboardRank": {
+            "type": "object",
+            "properties": {
+                "leaderboardId": {
+                    "description": "Leaderboard's ID",
+                    "type": "string"
+                },
+                "leaderboardName": {
+                    "description": "Leaderboard's name",
+                    "type": "string"
+                },
+                "percentile": {
+                    "description": "Top percentage of the ranked players that the player is in",
+                    "type": "number"
+                },
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "Player ranking position",
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Name of the tier that the player is placed on. Empty when no tier places the player",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Number of players ranked on the leaderboard",
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "Player rank value",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.Leaderboards": {
+            "type": "object",
+            "properties": {
+                "leaderboards": {
+                    "description": "Leaderboards page, most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Leaderboard"
+                    }
+                },
+                "total": {
+                    "description": "Number of leaderboards that match the filters",
+                    "type": "integer"
+                }
+            }
+        },
+        "rest.PlayerHistory": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "description": "Cursor of the next page. Empty on the last one",
+                    "type": "string"
+                },
+                "submissions": {
+                    "description": "History page, most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Submission"
+                    }
+                }
+            }
+        },
+        "rest.PlayerLeaderboardsRanks": {
+            "type": "object",
+            "properties": {
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "ranks": {
+                    "description": "Player's ranks on the leaderboards that rank it",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.LeaderboardRank"
+                    }
+                }
+            }
+        },
+        "rest.PlayerQuestProgression": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "description": "Time the player completed the quest",
+                    "type": "string"
+                },
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "quest": {
+                    "description": "Quest Config Data",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    ]
+                },
+                "questVersion": {
+                    "description": "Quest version the player is progressing on",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time the player started the quest",
+                    "type": "string"
+                },
+                "tasksProgression": {
+                    "description": "Tasks progression",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.PlayerQuestTaskProgression"
+                    }
+                },
+                "updatedAt": {
+                    "description": "Last time the player updated the quest progression",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.PlayerQuestSummary": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "description": "Time the player completed the quest",
+                    "type": "string"
+                },
+                "quest": {
+                    "description": "Quest config data, without its tasks",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rest.Quest"
+                        }
+                    ]
+                },
+                "questVersion": {
+                    "description": "Quest version the player is progressing on",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time the player started the quest",
+                    "type": "string"
+                },
+                "tasksCompleted": {
+                    "description": "Number of the quest tasks completed by the player",
+                    "type": "integer"
+                },
+                "tasksTotal": {
+                    "description": "Number of tasks of the quest",
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "description": "Last time the player updated the quest progression",
+                    "type": "string"
+                }
+            }
+        },
+        "rest.PlayerQuestTaskProgression": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "description": "Time the player completed the task",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Leaderboard's name",
+                "startedAt": {
+                    "description": "Time the player started the task",
                     "type": "string"
                 },
-                "ordering": {
-                    "description": "Leaderboard ranking order",
-                    "type": "string",
-                    "enum": [
-                        "ASC",
-                        "DESC"
+                "task": {
+                    "description": "Task config data",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rest.Task"
+                        }
                     ]
                 },
-                "startAt": {
-                    "description": "Time that the leaderboard should start working",
+                "updatedAt": {
+                    "description": "Last time the player updated the task progression",
                     "type": "string"
                 }
             }
         },
-        "rest.CreateQuestReq": {
+        "rest.PlayerQuests": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "Quest details",
+                "playerId": {
+                    "description": "Player's ID",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Quest name",
-                    "type": "string"
+                "quests": {
+                    "description": "Player quests page, most recently started first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.PlayerQuestSummary"
+                    }
                 },
-                "tasks": {
-                    "description": "Quest task list",
+                "total": {
+                    "description": "Number of quests started by the player",
+                    "type": "integer"
+                }
+            }
+        },
+        "rest.PlayerRank": {
+            "type": "object",
+            "properties": {
+                "above": {
+                    "description": "Players ranked right above the player, closest last",
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "dependsOn": {
-                                "description": "List of array indexes of the tasks that needs to be completed before this one can be started",
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            },
-                            "description": {
-                                "description": "Task details",
-                                "type": "string"
-                            },
-                            "name": {
-                                "description": "Task name",
-                                "type": "string"
-                            },
-                            "requiredForCompletion": {
-                                "description": "Is this task required for the quest completion? Defaults to ` + "`" + `true` + "`" + `",
-                                "type": "boolean"
-                            },
-                            "rule": {
-                                "description": "Task completion logic as JsonLogic. See https://jsonlogic.com/",
-                                "type": "string"
-                            }
-                        }
+                        "$ref": "#/definitions/rest.Rank"
                     }
                 },
-                "tasksValidators": {
-                    "description": "Quest task list success validation data",
+                "below": {
+                    "description": "Players ranked right below the player, closest first",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/rest.Rank"
                     }
+                },
+                "percentile": {
+                    "description": "Top percentage of the ranked players that the player is in",
+                    "type": "number"
+                },
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "Player ranking position",
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Name of the tier that the player is placed on. Empty when no tier places the player",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Number of players ranked on the leaderboard",
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "Player rank value",
+                    "type": "number"
                 }
             }
         },
-        "rest.CreateStatisticReq": {
+        "rest.PlayerStatisticProgression": {
             "type": "object",
             "properties": {
-                "aggregationMode": {
-                    "description": "Data aggregation mode",
-                    "type": "string",
-                    "enum": [
-                        "SUM",
-                        "SUB",
-                        "MAX",
-                        "MIN"
-                    ]
+                "currentValue": {
+                    "description": "Current progression value",
+                    "type": "number"
                 },
-                "description": {
-                    "description": "Statistic details",
-                    "type": "string"
+                "goalCompleted": {
+                    "description": "Has the player reached the goal?",
+                    "type": "boolean"
                 },
-                "goal": {
-                    "description": "Goal value. nil means no goal",
-                    "type": "number"
+                "goalCompletedAt": {
+                    "description": "Time the player reached the goal",
+                    "type": "string"
                 },
-                "initialValue": {
-                    "description": "Initial statistic value for players. Defaults to zero on ` + "`" + `'aggregationMode' in ['SUM', 'SUB']` + "`" + `",
+                "goalValue": {
+                    "description": "Statistic's goal",
                     "type": "number"
                 },
                 "landmarks": {
-                    "description": "Statistic landmarks",
+                    "description": "Landmarks player progression",
                     "type": "array",
                     "items": {
-                        "type": "number"
+                        "$ref": "#/definitions/rest.PlayerStatisticProgressionLandmark"
                     }
                 },
-                "name": {
-                    "description": "Statistic name",
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "startedAt": {
+                    "description": "Time the player started the progression for the given statistic",
+                    "type": "string"
+                },
+                "statisticId": {
+                    "description": "Statistic ID",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Last time the player updated it's statistic progress",
                     "type": "string"
                 }
             }
         },
-        "rest.ErrorResponse": {
+        "rest.PlayerStatisticProgressionLandmark": {
             "type": "object",
             "properties": {
-                "code": {
-                    "description": "Error unique code",
+                "completed": {
+                    "description": "Has the player reached the landmark?",
+                    "type": "boolean"
+                },
+                "completedAt": {
+                    "description": "Time the player reached the landmark",
                     "type": "string"
                 },
-                "details": {
-                    "description": "Details about the source of the error",
+                "value": {
+                    "description": "Landmark value",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.PlayersRanking": {
+            "type": "object",
+            "properties": {
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
+                "ranks": {
+                    "description": "Ranks of the players requested that are ranked",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/rest.RelativeRank"
                     }
                 },
-                "message": {
-                    "description": "Error message",
-                    "type": "string"
+                "total": {
+                    "description": "Number of players ranked on the leaderboard",
+                    "type": "integer"
                 }
             }
         },
-        "rest.Leaderboard": {
+        "rest.Quest": {
             "type": "object",
             "properties": {
-                "aggregationMode": {
-                    "description": "Data aggregation mode",
-                    "type": "string",
-                    "enum": [
-                        "INC",
-                        "MAX",
-                        "MIN"
-                    ]
-                },
                 "createdAt": {
-                    "description": "Time that the leaderboard was created",
+                    "description": "Time that the quest was created",
                     "type": "string"
                 },
                 "description": {
-                    "description": "Leaderboard's description",
-                    "type": "string"
-                },
-                "endAt": {
-                    "description": "Time that the leaderboard will be closed for new updates",
+                    "description": "Quest details",
                     "type": "string"
                 },
                 "gameId": {
-                    "description": "The ID from the game that is responsible for the leaderboard",
+                    "description": "ID of the game responsible for the quest",
                     "type": "string"
                 },
                 "id": {
-                    "description": "Leaderboard's ID",
+                    "description": "Quest ID",
                     "type": "string"
                 },
                 "name": {
-                    "description": "Leaderboard's name",
+                    "description": "Quest name",
                     "type": "string"
                 },
-                "ordering": {
-                    "description": "Leaderboard ranking order",
-                    "type": "string",
-                    "enum": [
-                        "ASC",
-                        "DESC"
-                    ]
-                },
-                "startAt": {
-                    "description": "Time that the leaderboard should start working",
-                    "type": "string"
+                "tasks": {
+                    "description": "Quest task list",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Task"
+                    }
                 },
                 "updatedAt": {
-                    "description": "Last time that the leaderboard info was updated",
-                    "type": "string"
-                }
-            }
-        },
-        "rest.PlayerQuestProgression": {
-            "type": "object",
-            "properties": {
-                "completedAt": {
-                    "description": "Time the player completed the quest",
-                    "type": "string"
-                },
-                "playerId": {
-                    "description": "Player's ID",
-                    "type": "string"
-                },
-                "quest": {
-                    "description": "Quest Config Data",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/rest.Quest"
-                        }
-                    ]
-                },
-                "startedAt": {
-                    "description": "Time the player started the quest",
+                    "description": "Last time that the quest was updated",
                     "type": "string"
                 },
-                "tasksProgression": {
-                    "description": "Tasks progression",
+                "version": {
+                    "description": "Quest version, increased on every update",
+                    "type": "integer"
+                }
+            }
+        },
+        "rest.Quests": {
+            "type": "object",
+            "properties": {
+                "quests": {
+                    "description": "Quests page, most recent first",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/rest.PlayerQuestTaskProgression"
+                        "$ref": "#/definitions/rest.Quest"
                     }
                 },
-                "updatedAt": {
-                    "description": "Last time the player updated the quest progression",
-                    "type": "string"
+                "total": {
+                    "description": "Number of quests that match the filters",
+                    "type": "integer"
                 }
             }
         },
-        "rest.PlayerQuestTaskProgression": {
+        "rest.Rank": {
             "type": "object",
             "properties": {
-                "completedAt": {
-                    "description": "Time the player completed the task",
+                "percentile": {
+                    "description": "Top percentage of the ranked players that the player is in",
+                    "type": "number"
+                },
+                "playerId": {
+                    "description": "Player's ID",
                     "type": "string"
                 },
-                "startedAt": {
-                    "description": "Time the player started the task",
+                "position": {
+                    "description": "Player ranking position",
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Name of the tier that the player is placed on. Empty when no tier places the player",
                     "type": "string"
                 },
-                "task": {
-                    "description": "Task config data",
+                "value": {
+                    "description": "Player rank value",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.RankEntryFailure": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Reason why the entry was not applied",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/rest.Task"
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     ]
                 },
-                "updatedAt": {
-                    "description": "Last time the player updated the task progression",
+                "index": {
+                    "description": "Entry position on the request",
+                    "type": "integer"
+                },
+                "playerId": {
+                    "description": "Player's ID",
                     "type": "string"
                 }
             }
         },
-        "rest.PlayerStatisticProgression": {
+        "rest.Ranking": {
             "type": "object",
             "properties": {
-                "currentValue": {
-                    "description": "Current progression value",
-                    "type": "number"
-                },
-                "goalCompleted": {
-                    "description": "Has the player reached the goal?",
-                    "type": "boolean"
-                },
-                "goalCompletedAt": {
-                    "description": "Time the player reached the goal",
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
                     "type": "string"
                 },
-                "goalValue": {
-                    "description": "Statistic's goal",
-                    "type": "number"
-                },
-                "landmarks": {
-                    "description": "Landmarks player progression",
+                "ranks": {
+                    "description": "Ranking page",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/rest.PlayerStatisticProgressionLandmark"
+                        "$ref": "#/definitions/rest.Rank"
                     }
                 },
+                "total": {
+                    "description": "Number of players ranked on the leaderboard",
+                    "type": "integer"
+                }
+            }
+        },
+        "rest.Rejection": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "description": "Client that made the submission",
+                    "type": "string"
+                },
+                "gameId": {
+                    "description": "ID of the game that made the submission",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Rejection ID",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "Ranking period ID that would receive the submission. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
                 "playerId": {
                     "description": "Player's ID",
                     "type": "string"
                 },
-                "startedAt": {
-                    "description": "Time the player started the progression for the given statistic",
+                "reason": {
+                    "description": "Why the submission was rejected",
                     "type": "string"
                 },
-                "statisticId": {
-                    "description": "Statistic ID",
+                "rejectedAt": {
+                    "description": "Time that the submission was rejected",
                     "type": "string"
                 },
-                "updatedAt": {
-                    "description": "Last time the player updated it's statistic progress",
-                    "type": "string"
+                "value": {
+                    "description": "Value submitted",
+                    "type": "number"
                 }
             }
         },
-        "rest.PlayerStatisticProgressionLandmark": {
+        "rest.Rejections": {
             "type": "object",
             "properties": {
-                "completed": {
-                    "description": "Has the player reached the landmark?",
-                    "type": "boolean"
-                },
-                "completedAt": {
-                    "description": "Time the player reached the landmark",
+                "nextCursor": {
+                    "description": "Cursor of the next page. Empty on the last one",
                     "type": "string"
                 },
-                "value": {
-                    "description": "Landmark value",
-                    "type": "number"
+                "rejections": {
+                    "description": "Rejections page, most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.Rejection"
+                    }
                 }
             }
         },
-        "rest.Quest": {
+        "rest.RelativeRank": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "description": "Time that the quest was created",
-                    "type": "string"
+                "percentile": {
+                    "description": "Top percentage of the ranked players that the player is in",
+                    "type": "number"
                 },
-                "description": {
-                    "description": "Quest details",
+                "playerId": {
+                    "description": "Player's ID",
                     "type": "string"
                 },
-                "gameId": {
-                    "description": "ID of the game responsible for the quest",
+                "position": {
+                    "description": "Player ranking position",
+                    "type": "integer"
+                },
+                "relativePosition": {
+                    "description": "Player position among the players requested",
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Name of the tier that the player is placed on. Empty when no tier places the player",
                     "type": "string"
                 },
-                "id": {
-                    "description": "Quest ID",
+                "value": {
+                    "description": "Player rank value",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.Results": {
+            "type": "object",
+            "properties": {
+                "finalizedAt": {
+                    "description": "Time that the ranking was frozen",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Quest name",
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
                     "type": "string"
                 },
-                "tasks": {
-                    "description": "Quest task list",
+                "ranks": {
+                    "description": "Final ranking page",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/rest.Task"
+                        "$ref": "#/definitions/rest.Rank"
                     }
                 },
-                "updatedAt": {
-                    "description": "Last time that the quest was updated",
-                    "type": "string"
+                "total": {
+                    "description": "Number of players ranked on the leaderboard",
+                    "type": "integer"
                 }
             }
         },
-        "rest.Rank": {
+        "rest.Reward": {
             "type": "object",
             "properties": {
+                "period": {
+                    "description": "Ranking period ID. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
                 "playerId": {
                     "description": "Player's ID",
                     "type": "string"
                 },
                 "position": {
-                    "description": "Player ranking position",
+                    "description": "Player's final ranking position",
                     "type": "integer"
                 },
+                "tier": {
+                    "description": "Name of the tier earned by the player",
+                    "type": "string"
+                },
                 "value": {
-                    "description": "Player rank value",
+                    "description": "Player's final rank value",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.RewardTier": {
+            "type": "object",
+            "properties": {
+                "fromPosition": {
+                    "description": "First ranking position awarded by the tier. Ignored on percentile tiers",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Tier's name, unique on the leaderboard",
+                    "type": "string"
+                },
+                "percentile": {
+                    "description": "Top percentage of the ranked players awarded by the tier. Zero for rank range tiers",
                     "type": "number"
+                },
+                "toPosition": {
+                    "description": "Last ranking position awarded by the tier. Ignored on percentile tiers",
+                    "type": "integer"
                 }
             }
         },
@@ -1365,6 +3545,68 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.Submission": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "description": "Client that made the submission",
+                    "type": "string"
+                },
+                "gameId": {
+                    "description": "ID of the game that made the submission",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Submission ID",
+                    "type": "string"
+                },
+                "period": {
+                    "description": "Ranking period ID that received the submission. Empty for non recurring leaderboards",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Player rank value right after the submission",
+                    "type": "number"
+                },
+                "submittedAt": {
+                    "description": "Time that the submission was applied",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value submitted",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.SubmissionRules": {
+            "type": "object",
+            "properties": {
+                "maxIncrease": {
+                    "description": "Highest value a single submission can add to the player's rank. ` + "`" + `INC` + "`" + ` leaderboards only, zero when there's no limit",
+                    "type": "number"
+                },
+                "maxSubmissions": {
+                    "description": "Submissions accepted per player on each rate window. Zero when there's no limit",
+                    "type": "integer"
+                },
+                "maxValue": {
+                    "description": "Highest value accepted per submission. Null when there's no upper bound",
+                    "type": "number"
+                },
+                "minValue": {
+                    "description": "Lowest value accepted per submission. Null when there's no lower bound",
+                    "type": "number"
+                },
+                "rateWindowSeconds": {
+                    "description": "Window, in seconds, used to limit the player's submissions",
+                    "type": "integer"
+                },
+                "recordRejected": {
+                    "description": "Whether the rejected submissions are recorded for review",
+                    "type": "boolean"
+                }
+            }
+        },
         "rest.Task": {
             "type": "object",
             "properties": {
@@ -1387,6 +3629,10 @@ const docTemplate = `{
                     "description": "Task ID",
                     "type": "string"
                 },
+                "key": {
+                    "description": "Task key that identifies it across the quest versions",
+                    "type": "string"
+                },
                 "name": {
                     "description": "Task name",
                     "type": "string"
@@ -1405,6 +3651,44 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.Tier": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Tier's name, unique on the leaderboard",
+                    "type": "string"
+                },
+                "percentile": {
+                    "description": "Top percentage of the ranked players placed on the tier. Zero for score threshold tiers",
+                    "type": "number"
+                },
+                "threshold": {
+                    "description": "Value that the players must reach, following the leaderboard ordering, to be placed on the tier. Ignored on percentile tiers",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.UpdateLeaderboardReq": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Leaderboard's new description. Kept when omitted",
+                    "type": "string"
+                },
+                "endAt": {
+                    "description": "Time that the leaderboard will be closed for new updates. Kept when omitted",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Leaderboard's new name. Kept when omitted",
+                    "type": "string"
+                },
+                "removeEndAt": {
+                    "description": "Removes the end time so the leaderboard never closes, ignoring ` + "`" + `endAt` + "`" + `",
+                    "type": "boolean"
+                }
+            }
+        },
         "rest.UpdatePlayerQuestProgressionReq": {
             "type": "object",
             "properties": {
@@ -1414,15 +3698,78 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.UpsertPlayerLeaderboardsRanksReq": {
+            "type": "object",
+            "properties": {
+                "values": {
+                    "description": "Values that will be used to update the player's rank, by leaderboard ID",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
         "rest.UpsertPlayerRankReq": {
             "type": "object",
             "properties": {
+                "scopes": {
+                    "description": "Scope partitions, by dimension, that will also rank the player",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "platform": "pc",
+                        "region": "eu"
+                    }
+                },
+                "value": {
+                    "description": "Value that will be used to update the player's rank",
+                    "type": "number"
+                }
+            }
+        },
+        "rest.UpsertPlayerRanksEntryReq": {
+            "type": "object",
+            "properties": {
+                "playerId": {
+                    "description": "Player's ID",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scope partitions, by dimension, that will also rank the player",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "platform": "pc",
+                        "region": "eu"
+                    }
+                },
                 "value": {
                     "description": "Value that will be used to update the player's rank",
                     "type": "number"
                 }
             }
         },
+        "rest.UpsertPlayerRanksResult": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "description": "Number of entries applied",
+                    "type": "integer"
+                },
+                "failures": {
+                    "description": "Entries that were not applied",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rest.RankEntryFailure"
+                    }
+                }
+            }
+        },
         "rest.UpsertPlayerStatisticProgressionReq": {
             "type": "object",
             "properties": {
@@ -61,6 +61,12 @@ func buildErrorHandler() fiber.ErrorHandler {
 			return c.Status(http.StatusNotFound).JSON(ErrorResponsePlayerNotStartedTheQuest)
 		case errors.Is(err, quest.ErrPlayerQuestAlreadyCompleted):
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponsePlayerQuestAlreadyFinished)
+		case errors.Is(err, quest.ErrInvalidPageNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseQuestPageNumber)
+		case errors.Is(err, quest.ErrInvalidLimitNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseQuestLimitNumber)
+		case errors.Is(err, quest.ErrInvalidCreatedRange):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseQuestCreatedRange)
 		case errors.Is(err, quest.ErrQuestValidationError):
 			validationErrorMessages := strings.Split(err.Error(), "\n")
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseQuestInvalid.withDetails(validationErrorMessages...))
@@ -71,6 +77,42 @@ func buildErrorHandler() fiber.ErrorHandler {
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingPageNumber)
 		case errors.Is(err, leaderboard.ErrInvalidLimitNumber):
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingLimitNumber)
+		case errors.Is(err, leaderboard.ErrInvalidAroundNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingAround)
+		case errors.Is(err, leaderboard.ErrPlayerRankNotFound):
+			return c.Status(http.StatusNotFound).JSON(ErrorResponsePlayerRankNotFound)
+		case errors.Is(err, leaderboard.ErrInvalidEntriesNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingEntries)
+		case errors.Is(err, leaderboard.ErrInvalidLeaderboardsNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingLeaderboards)
+		case errors.Is(err, leaderboard.ErrInvalidExportFormat):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseExportFormat)
+		case errors.Is(err, leaderboard.ErrValueOutOfBounds):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseValueOutOfBounds)
+		case errors.Is(err, leaderboard.ErrIncreaseTooHigh):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseIncreaseTooHigh)
+		case errors.Is(err, leaderboard.ErrTooManySubmissions):
+			return c.Status(http.StatusTooManyRequests).JSON(ErrorResponseTooManySubmissions)
+		case errors.Is(err, leaderboard.ErrInvalidCursor):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseHistoryInvalidCursor)
+		case errors.Is(err, leaderboard.ErrInvalidPlayerID):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingPlayerID)
+		case errors.Is(err, leaderboard.ErrPlayerBanned):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponsePlayerBanned)
+		case errors.Is(err, leaderboard.ErrBanNotFound):
+			return c.Status(http.StatusNotFound).JSON(ErrorResponseBanNotFound)
+		case errors.Is(err, leaderboard.ErrInvalidBanReason):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseBanInvalidReason)
+		case errors.Is(err, leaderboard.ErrInvalidPlayersNumber):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingPlayers)
+		case errors.Is(err, leaderboard.ErrInvalidPeriod):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingPeriod)
+		case errors.Is(err, leaderboard.ErrResultsNotFound):
+			return c.Status(http.StatusNotFound).JSON(ErrorResponseResultsNotFound)
+		case errors.Is(err, leaderboard.ErrRewardNotFound):
+			return c.Status(http.StatusNotFound).JSON(ErrorResponseRewardNotFound)
+		case errors.Is(err, leaderboard.ErrInvalidStatus):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseLeaderboardStatus)
 		case errors.Is(err, leaderboard.ErrInvalidLeaderboardID):
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseLeaderboardInvalidID)
 		case errors.Is(err, leaderboard.ErrLeaderboardNotFound):
@@ -78,6 +120,8 @@ func buildErrorHandler() fiber.ErrorHandler {
 		case errors.Is(err, leaderboard.ErrValidationError):
 			validationErrorMessages := strings.Split(err.Error(), "\n")
 			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseLeaderboardInvalid.withDetails(validationErrorMessages...))
+		case errors.Is(err, leaderboard.ErrInvalidScope):
+			return c.Status(http.StatusUnprocessableEntity).JSON(ErrorResponseRankingScope)
 		// Unknown
 		case errors.As(err, &jsonErr):
 			return c.Status(http.StatusBadRequest).JSON(ErrorResponseInvalidRequestBody)
@@ -0,0 +1,178 @@
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gabapcia/gameblitz/internal/infra/logger/zap"
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+var exportContentTypes = map[string]string{
+	ExportFormatCSV:    "text/csv",
+	ExportFormatNDJSON: "application/x-ndjson",
+}
+
+var ErrorResponseExportFormat = ErrorResponse{Code: "2.20", Message: "invalid export format"}
+
+// Exports are streamed, so their responses must never go through the cache, which buffers the whole body
+func isExportRequest(c *fiber.Ctx) bool {
+	return strings.HasSuffix(c.Path(), "/export")
+}
+
+func writeCSVExport(ctx context.Context, w *bufio.Writer, export leaderboard.Export) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"position", "playerId", "value", "percentile", "tier"}); err != nil {
+		return err
+	}
+
+	return export.Stream(ctx, func(ranks []leaderboard.Rank) error {
+		for _, rank := range ranks {
+			record := []string{
+				strconv.FormatInt(rank.Position, 10),
+				rank.PlayerID,
+				strconv.FormatFloat(rank.Value, 'f', -1, 64),
+				strconv.FormatFloat(rank.Percentile, 'f', -1, 64),
+				rank.Tier,
+			}
+
+			if err := csvWriter.Write(record); err != nil {
+				return err
+			}
+		}
+
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+
+		// Sends the chunk right away. Fails when the client is gone, which stops the export
+		return w.Flush()
+	})
+}
+
+func writeNDJSONExport(ctx context.Context, w *bufio.Writer, export leaderboard.Export) error {
+	encoder := json.NewEncoder(w)
+	return export.Stream(ctx, func(ranks []leaderboard.Rank) error {
+		for _, rank := range ranks {
+			if err := encoder.Encode(rankFromDomain(rank)); err != nil {
+				return err
+			}
+		}
+
+		// Sends the chunk right away. Fails when the client is gone, which stops the export
+		return w.Flush()
+	})
+}
+
+// Streams the export on the response body after the handler returns.
+// The status is already sent by then, so a failure halfway through the export can only cut the response short
+func streamExport(c *fiber.Ctx, leaderboardID, format string, export leaderboard.Export) {
+	filename := leaderboardID
+	if export.Period != "" {
+		filename += "-" + export.Period
+	}
+
+	c.Attachment(filename + "." + format)
+	c.Set(fiber.HeaderContentType, exportContentTypes[format])
+
+	ctx := c.Context()
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		var err error
+		switch format {
+		case ExportFormatCSV:
+			err = writeCSVExport(ctx, w, export)
+		case ExportFormatNDJSON:
+			err = writeNDJSONExport(ctx, w, export)
+		}
+
+		if err != nil {
+			zap.Error(err, "ranking export error")
+		}
+	})
+}
+
+// @summary Export Leaderboard Ranking
+// @description Stream the whole leaderboard ranking as CSV or NDJSON. The ranking isn't frozen while it's exported, so players that change their positions meanwhile may be exported twice or skipped
+// @router /api/v1/leaderboards/{leaderboardId}/ranking/export [GET]
+// @produce text/csv
+// @produce application/x-ndjson
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param format query string false "Export format" Enums(csv, ndjson) default(csv)
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the current one"
+// @param scope query string false "Scope partition formatted as `{dimension}:{value}`, like `region:eu`. Defaults to the global ranking"
+// @success 200 {file} file
+// @failure 404,422,500 {object} ErrorResponse
+func buildExportRankingHandler(exportRankingFunc leaderboard.ExportRankingFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		format := c.Query("format", ExportFormatCSV)
+		if _, ok := exportContentTypes[format]; !ok {
+			return leaderboard.ErrInvalidExportFormat
+		}
+
+		// Copied since they're read by the stream, after the handler returns
+		scope, err := leaderboard.ParseScope(utils.CopyString(c.Query("scope")))
+		if err != nil {
+			return err
+		}
+
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			period      = utils.CopyString(c.Query("period"))
+		)
+
+		export, err := exportRankingFunc(c.Context(), leaderboard, period, scope)
+		if err != nil {
+			return err
+		}
+
+		streamExport(c, leaderboard.ID, format, export)
+		return nil
+	}
+}
+
+// @summary Export Leaderboard Results
+// @description Stream the whole final ranking of a closed leaderboard period as CSV or NDJSON
+// @router /api/v1/leaderboards/{leaderboardId}/results/export [GET]
+// @produce text/csv
+// @produce application/x-ndjson
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param format query string false "Export format" Enums(csv, ndjson) default(csv)
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the last one closed"
+// @success 200 {file} file
+// @failure 404,422,500 {object} ErrorResponse
+func buildExportResultsHandler(exportResultsFunc leaderboard.ExportResultsFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		format := c.Query("format", ExportFormatCSV)
+		if _, ok := exportContentTypes[format]; !ok {
+			return leaderboard.ErrInvalidExportFormat
+		}
+
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			period      = utils.CopyString(c.Query("period"))
+		)
+
+		export, err := exportResultsFunc(c.Context(), leaderboard, period)
+		if err != nil {
+			return err
+		}
+
+		streamExport(c, leaderboard.ID, format, export)
+		return nil
+	}
+}
@@ -0,0 +1,271 @@
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabapcia/gameblitz/internal/auth"
+	"github.com/gabapcia/gameblitz/internal/infra/logger/zap"
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildExport(period string, chunks ...[]leaderboard.Rank) leaderboard.Export {
+	return leaderboard.Export{
+		Period: period,
+		Stream: func(ctx context.Context, write func(ranks []leaderboard.Rank) error) error {
+			for _, chunk := range chunks {
+				if err := write(chunk); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestBuildExportRankingHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK CSV", func(t *testing.T) {
+		exported := 0
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			ExportRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope) (leaderboard.Export, error) {
+				exported++
+				assert.Equal(t, leaderboard.Scope{Dimension: "region", Value: "eu"}, scope)
+				return buildExport("",
+					[]leaderboard.Rank{{PlayerID: "p1", Position: 0, Value: 30, Percentile: 50, Tier: "Gold"}},
+					[]leaderboard.Rank{{PlayerID: "p2", Position: 1, Value: 10.5, Percentile: 100}},
+				), nil
+			},
+		})
+
+		// Requested twice since exports must never be served from the cache
+		for range 2 {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/export?scope=region:eu", leaderboardID), nil)
+			req.Header.Set("Authorization", uuid.NewString())
+
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+			assert.Contains(t, resp.Header.Get("Content-Disposition"), leaderboardID+".csv")
+
+			records, err := csv.NewReader(resp.Body).ReadAll()
+			assert.NoError(t, err)
+			assert.Equal(t, [][]string{
+				{"position", "playerId", "value", "percentile", "tier"},
+				{"0", "p1", "30", "50", "Gold"},
+				{"1", "p2", "10.5", "100", ""},
+			}, records)
+		}
+
+		assert.Equal(t, 2, exported)
+	})
+
+	t.Run("OK NDJSON", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID, Recurrence: leaderboard.RecurrenceDaily}, nil
+			},
+			ExportRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope) (leaderboard.Export, error) {
+				assert.Equal(t, "2024-01-01", period)
+				return buildExport(period,
+					[]leaderboard.Rank{{PlayerID: "p1", Position: 0, Value: 30}, {PlayerID: "p2", Position: 1, Value: 10}},
+				), nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/export?format=ndjson&period=2024-01-01", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), leaderboardID+"-2024-01-01.ndjson")
+
+		ranks := make([]Rank, 0)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var rank Rank
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &rank))
+			ranks = append(ranks, rank)
+		}
+
+		assert.Equal(t, []Rank{{PlayerID: "p1", Position: 0, Value: 30}, {PlayerID: "p2", Position: 1, Value: 10}}, ranks)
+	})
+
+	t.Run("Invalid Format", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/export?format=xml", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseExportFormat, body)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			ExportRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope) (leaderboard.Export, error) {
+				return leaderboard.Export{}, leaderboard.ErrInvalidPeriod
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/export?period=2024-01", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingPeriod, body)
+	})
+
+	t.Run("Stream Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			ExportRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope) (leaderboard.Export, error) {
+				return leaderboard.Export{
+					Stream: func(ctx context.Context, write func(ranks []leaderboard.Rank) error) error {
+						if err := write([]leaderboard.Rank{{PlayerID: "p1"}}); err != nil {
+							return err
+						}
+
+						return errors.New("any error")
+					},
+				}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/export", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 2)
+	})
+}
+
+func TestBuildExportResultsHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID, Recurrence: leaderboard.RecurrenceWeekly}, nil
+			},
+			ExportResultsFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string) (leaderboard.Export, error) {
+				assert.Equal(t, "2024-W01", period)
+				return buildExport(period, []leaderboard.Rank{{PlayerID: "p1", Position: 0, Value: 30}}), nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/results/export?period=2024-W01", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), leaderboardID+"-2024-W01.csv")
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"position", "playerId", "value", "percentile", "tier"},
+			{"0", "p1", "30", "0", ""},
+		}, records)
+	})
+
+	t.Run("Results Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			ExportResultsFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string) (leaderboard.Export, error) {
+				return leaderboard.Export{}, leaderboard.ErrResultsNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/results/export", leaderboardID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseResultsNotFound, body)
+	})
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type Submission struct {
+	ID          string    `json:"id"`          // Submission ID
+	Period      string    `json:"period"`      // Ranking period ID that received the submission. Empty for non recurring leaderboards
+	Value       float64   `json:"value"`       // Value submitted
+	Score       float64   `json:"score"`       // Player rank value right after the submission
+	GameID      string    `json:"gameId"`      // ID of the game that made the submission
+	Client      string    `json:"client"`      // Client that made the submission
+	SubmittedAt time.Time `json:"submittedAt"` // Time that the submission was applied
+}
+
+type PlayerHistory struct {
+	Submissions []Submission `json:"submissions"` // History page, most recent first
+	NextCursor  string       `json:"nextCursor"`  // Cursor of the next page. Empty on the last one
+}
+
+func playerHistoryFromDomain(h leaderboard.History) PlayerHistory {
+	submissions := make([]Submission, len(h.Submissions))
+	for i, submission := range h.Submissions {
+		submissions[i] = Submission{
+			ID:          submission.ID,
+			Period:      submission.Period,
+			Value:       submission.Value,
+			Score:       submission.Score,
+			GameID:      submission.GameID,
+			Client:      submission.Client,
+			SubmittedAt: submission.SubmittedAt,
+		}
+	}
+
+	return PlayerHistory{
+		Submissions: submissions,
+		NextCursor:  h.NextCursor,
+	}
+}
+
+var ErrorResponseHistoryInvalidCursor = ErrorResponse{Code: "2.15", Message: "invalid cursor"}
+
+// @summary Player Rank History
+// @description Get every submission made to the player's rank paginated, most recent first
+// @router /api/v1/leaderboards/{leaderboardId}/ranking/{playerId}/history [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @param cursor query string false "Cursor returned by the previous page. Starts from the most recent submission when empty"
+// @param limit query int false "Number of submissions per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} PlayerHistory
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetPlayerHistoryHandler(playerHistoryFunc leaderboard.PlayerHistoryFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+			cursor      = c.Query("cursor")
+			limit       = c.QueryInt("limit", 10)
+		)
+
+		history, err := playerHistoryFunc(c.Context(), leaderboard, playerID, cursor, int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(playerHistoryFromDomain(history))
+	}
+}
@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/auth"
+	"github.com/gabapcia/gameblitz/internal/infra/logger/zap"
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildGetPlayerHistoryHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerHistoryFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID, cursor string, limit int64) (leaderboard.History, error) {
+				assert.Equal(t, "1-0", cursor)
+				assert.Equal(t, int64(1), limit)
+				return leaderboard.History{
+					Submissions: []leaderboard.Submission{
+						{ID: "0-1", Value: 10, Score: 25, Caller: leaderboard.Caller{GameID: lb.GameID, Client: "127.0.0.1"}, SubmittedAt: time.Now()},
+					},
+					NextCursor: "0-1",
+				}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s/history?cursor=1-0&limit=1", leaderboardID, playerID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body PlayerHistory
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Len(t, body.Submissions, 1)
+		assert.Equal(t, float64(25), body.Submissions[0].Score)
+		assert.Equal(t, gameID, body.Submissions[0].GameID)
+		assert.Equal(t, "0-1", body.NextCursor)
+	})
+
+	t.Run("Invalid Cursor", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerHistoryFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID, cursor string, limit int64) (leaderboard.History, error) {
+				return leaderboard.History{}, leaderboard.ErrInvalidCursor
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s/history", leaderboardID, playerID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseHistoryInvalidCursor.Code, body.Code)
+		assert.Equal(t, ErrorResponseHistoryInvalidCursor.Message, body.Message)
+	})
+
+	t.Run("Invalid Limit Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerHistoryFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID, cursor string, limit int64) (leaderboard.History, error) {
+				return leaderboard.History{}, leaderboard.ErrInvalidLimitNumber
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s/history", leaderboardID, playerID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingLimitNumber.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingLimitNumber.Message, body.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerHistoryFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID, cursor string, limit int64) (leaderboard.History, error) {
+				return leaderboard.History{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s/history", leaderboardID, playerID), nil)
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, body.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
+	})
+}
@@ -13,29 +13,171 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+type RewardTier struct {
+	Name         string  `json:"name"`         // Tier's name, unique on the leaderboard
+	FromPosition int64   `json:"fromPosition"` // First ranking position awarded by the tier. Ignored on percentile tiers
+	ToPosition   int64   `json:"toPosition"`   // Last ranking position awarded by the tier. Ignored on percentile tiers
+	Percentile   float64 `json:"percentile"`   // Top percentage of the ranked players awarded by the tier. Zero for rank range tiers
+}
+
+type Tier struct {
+	Name       string  `json:"name"`       // Tier's name, unique on the leaderboard
+	Percentile float64 `json:"percentile"` // Top percentage of the ranked players placed on the tier. Zero for score threshold tiers
+	Threshold  float64 `json:"threshold"`  // Value that the players must reach, following the leaderboard ordering, to be placed on the tier. Ignored on percentile tiers
+}
+
+type SubmissionRules struct {
+	MinValue          *float64 `json:"minValue"`          // Lowest value accepted per submission. Null when there's no lower bound
+	MaxValue          *float64 `json:"maxValue"`          // Highest value accepted per submission. Null when there's no upper bound
+	MaxIncrease       float64  `json:"maxIncrease"`       // Highest value a single submission can add to the player's rank. `INC` leaderboards only, zero when there's no limit
+	MaxSubmissions    int64    `json:"maxSubmissions"`    // Submissions accepted per player on each rate window. Zero when there's no limit
+	RateWindowSeconds int64    `json:"rateWindowSeconds"` // Window, in seconds, used to limit the player's submissions
+	RecordRejected    bool     `json:"recordRejected"`    // Whether the rejected submissions are recorded for review
+}
+
+func (r SubmissionRules) toDomain() leaderboard.SubmissionRules {
+	return leaderboard.SubmissionRules{
+		MinValue:       r.MinValue,
+		MaxValue:       r.MaxValue,
+		MaxIncrease:    r.MaxIncrease,
+		MaxSubmissions: r.MaxSubmissions,
+		RateWindow:     time.Duration(r.RateWindowSeconds) * time.Second,
+		RecordRejected: r.RecordRejected,
+	}
+}
+
+func submissionRulesFromDomain(r leaderboard.SubmissionRules) SubmissionRules {
+	return SubmissionRules{
+		MinValue:          r.MinValue,
+		MaxValue:          r.MaxValue,
+		MaxIncrease:       r.MaxIncrease,
+		MaxSubmissions:    r.MaxSubmissions,
+		RateWindowSeconds: int64(r.RateWindow / time.Second),
+		RecordRejected:    r.RecordRejected,
+	}
+}
+
 type CreateLeaderboardReq struct {
-	Name            string    `json:"name"`                                // Leaderboard's name
-	Description     string    `json:"description"`                         // Leaderboard's description
-	StartAt         time.Time `json:"startAt"`                             // Time that the leaderboard should start working
-	EndAt           time.Time `json:"endAt"`                               // Time that the leaderboard will be closed for new updates
-	AggregationMode string    `json:"aggregationMode" enums:"INC,MAX,MIN"` // Data aggregation mode
-	Ordering        string    `json:"ordering" enums:"ASC,DESC"`           // Leaderboard ranking order
+	Name               string          `json:"name"`                                               // Leaderboard's name
+	Description        string          `json:"description"`                                        // Leaderboard's description
+	StartAt            time.Time       `json:"startAt"`                                            // Time that the leaderboard should start working
+	EndAt              time.Time       `json:"endAt"`                                              // Time that the leaderboard will be closed for new updates
+	AggregationMode    string          `json:"aggregationMode" enums:"INC,MAX,MIN,LAST,AVG,COUNT"` // Data aggregation mode
+	Ordering           string          `json:"ordering" enums:"ASC,DESC"`                          // Leaderboard ranking order
+	RankingMode        string          `json:"rankingMode" enums:"STANDARD,DENSE,ORDINAL"`         // How tied players are positioned on the ranking. Defaults to `ORDINAL`
+	TieBreakPolicy     string          `json:"tieBreakPolicy" enums:"EARLIEST,LATEST,NONE"`        // How tied players are ordered on the ranking. Defaults to `NONE`
+	Recurrence         string          `json:"recurrence" enums:"NONE,DAILY,WEEKLY,MONTHLY"`       // How often the ranking resets. Defaults to `NONE`
+	Timezone           string          `json:"timezone" example:"America/Sao_Paulo"`               // IANA timezone used to calculate the ranking periods. Defaults to `UTC`
+	RewardTiers        []RewardTier    `json:"rewardTiers"`                                        // Tiers awarded to the players when each period closes. The first tier that awards a player wins
+	Tiers              []Tier          `json:"tiers"`                                              // Tiers that the players are placed on, like bronze, silver and gold. The first tier that places a player wins
+	Scopes             []string        `json:"scopes" example:"region,platform"`                   // Dimensions that partition the ranking
+	SubmissionRules    SubmissionRules `json:"submissionRules"`                                    // Rules that every submission must follow to be applied
+	RollingWindowHours int64           `json:"rollingWindowHours"`                                 // Only the submissions made on the last hours count towards the ranking, and no rank change is notified. Zero when every submission counts
+	NotifyTop          int64           `json:"notifyTop"`                                          // Players displaced out of this top are notified. Zero when nobody is notified
+	StatisticID        string          `json:"statisticId"`                                        // Statistic whose player values feed the ranking. Only `LAST`, `MAX` and `MIN` leaderboards can be fed by a statistic
 }
 
 type Leaderboard struct {
-	CreatedAt       time.Time  `json:"createdAt"`                           // Time that the leaderboard was created
-	UpdatedAt       time.Time  `json:"updatedAt"`                           // Last time that the leaderboard info was updated
-	ID              string     `json:"id"`                                  // Leaderboard's ID
-	GameID          string     `json:"gameId"`                              // The ID from the game that is responsible for the leaderboard
-	Name            string     `json:"name"`                                // Leaderboard's name
-	Description     string     `json:"description"`                         // Leaderboard's description
-	StartAt         time.Time  `json:"startAt"`                             // Time that the leaderboard should start working
-	EndAt           *time.Time `json:"endAt"`                               // Time that the leaderboard will be closed for new updates
-	AggregationMode string     `json:"aggregationMode" enums:"INC,MAX,MIN"` // Data aggregation mode
-	Ordering        string     `json:"ordering" enums:"ASC,DESC"`           // Leaderboard ranking order
+	CreatedAt          time.Time       `json:"createdAt"`                                          // Time that the leaderboard was created
+	UpdatedAt          time.Time       `json:"updatedAt"`                                          // Last time that the leaderboard info was updated
+	ID                 string          `json:"id"`                                                 // Leaderboard's ID
+	GameID             string          `json:"gameId"`                                             // The ID from the game that is responsible for the leaderboard
+	Name               string          `json:"name"`                                               // Leaderboard's name
+	Description        string          `json:"description"`                                        // Leaderboard's description
+	StartAt            time.Time       `json:"startAt"`                                            // Time that the leaderboard should start working
+	EndAt              *time.Time      `json:"endAt"`                                              // Time that the leaderboard will be closed for new updates
+	AggregationMode    string          `json:"aggregationMode" enums:"INC,MAX,MIN,LAST,AVG,COUNT"` // Data aggregation mode
+	Ordering           string          `json:"ordering" enums:"ASC,DESC"`                          // Leaderboard ranking order
+	RankingMode        string          `json:"rankingMode" enums:"STANDARD,DENSE,ORDINAL"`         // How tied players are positioned on the ranking
+	TieBreakPolicy     string          `json:"tieBreakPolicy" enums:"EARLIEST,LATEST,NONE"`        // How tied players are ordered on the ranking
+	Recurrence         string          `json:"recurrence" enums:"NONE,DAILY,WEEKLY,MONTHLY"`       // How often the ranking resets
+	Timezone           string          `json:"timezone"`                                           // IANA timezone used to calculate the ranking periods
+	RewardTiers        []RewardTier    `json:"rewardTiers"`                                        // Tiers awarded to the players when each period closes
+	Tiers              []Tier          `json:"tiers"`                                              // Tiers that the players are placed on, like bronze, silver and gold
+	Scopes             []string        `json:"scopes"`                                             // Dimensions that partition the ranking
+	SubmissionRules    SubmissionRules `json:"submissionRules"`                                    // Rules that every submission must follow to be applied
+	RollingWindowHours int64           `json:"rollingWindowHours"`                                 // Only the submissions made on the last hours count towards the ranking, and no rank change is notified. Zero when every submission counts
+	NotifyTop          int64           `json:"notifyTop"`                                          // Players displaced out of this top are notified. Zero when nobody is notified
+	StatisticID        string          `json:"statisticId"`                                        // Statistic whose player values feed the ranking. Only `LAST`, `MAX` and `MIN` leaderboards can be fed by a statistic
+}
+
+type UpdateLeaderboardReq struct {
+	Name        *string    `json:"name"`        // Leaderboard's new name. Kept when omitted
+	Description *string    `json:"description"` // Leaderboard's new description. Kept when omitted
+	EndAt       *time.Time `json:"endAt"`       // Time that the leaderboard will be closed for new updates. Kept when omitted
+	RemoveEndAt bool       `json:"removeEndAt"` // Removes the end time so the leaderboard never closes, ignoring `endAt`
+}
+
+func (r UpdateLeaderboardReq) toDomain() leaderboard.UpdateLeaderboardData {
+	endAt := r.EndAt
+	if r.RemoveEndAt {
+		endAt = &time.Time{}
+	}
+
+	return leaderboard.UpdateLeaderboardData{
+		Name:        r.Name,
+		Description: r.Description,
+		EndAt:       endAt,
+	}
+}
+
+type Leaderboards struct {
+	Total        int64         `json:"total"`        // Number of leaderboards that match the filters
+	Leaderboards []Leaderboard `json:"leaderboards"` // Leaderboards page, most recent first
+}
+
+func leaderboardsFromDomain(l leaderboard.Leaderboards) Leaderboards {
+	leaderboards := make([]Leaderboard, len(l.Leaderboards))
+	for i, lb := range l.Leaderboards {
+		leaderboards[i] = leaderboardFromDomain(lb)
+	}
+
+	return Leaderboards{
+		Total:        l.Total,
+		Leaderboards: leaderboards,
+	}
 }
 
 func (r CreateLeaderboardReq) toDomain(gameID string) leaderboard.NewLeaderboardData {
+	rankingMode := leaderboard.RankingModeOrdinal
+	if r.RankingMode != "" {
+		rankingMode = r.RankingMode
+	}
+
+	tieBreakPolicy := leaderboard.TieBreakPolicyNone
+	if r.TieBreakPolicy != "" {
+		tieBreakPolicy = r.TieBreakPolicy
+	}
+
+	recurrence := leaderboard.RecurrenceNone
+	if r.Recurrence != "" {
+		recurrence = r.Recurrence
+	}
+
+	timezone := "UTC"
+	if r.Timezone != "" {
+		timezone = r.Timezone
+	}
+
+	rewardTiers := make([]leaderboard.RewardTier, len(r.RewardTiers))
+	for i, tier := range r.RewardTiers {
+		rewardTiers[i] = leaderboard.RewardTier{
+			Name:         tier.Name,
+			FromPosition: tier.FromPosition,
+			ToPosition:   tier.ToPosition,
+			Percentile:   tier.Percentile,
+		}
+	}
+
+	tiers := make([]leaderboard.Tier, len(r.Tiers))
+	for i, tier := range r.Tiers {
+		tiers[i] = leaderboard.Tier{
+			Name:       tier.Name,
+			Percentile: tier.Percentile,
+			Threshold:  tier.Threshold,
+		}
+	}
+
 	return leaderboard.NewLeaderboardData{
 		GameID:          gameID,
 		Name:            r.Name,
@@ -44,6 +186,17 @@ func (r CreateLeaderboardReq) toDomain(gameID string) leaderboard.NewLeaderboard
 		EndAt:           r.EndAt,
 		AggregationMode: r.AggregationMode,
 		Ordering:        r.Ordering,
+		RankingMode:     rankingMode,
+		TieBreakPolicy:  tieBreakPolicy,
+		Recurrence:      recurrence,
+		Timezone:        timezone,
+		RewardTiers:     rewardTiers,
+		Tiers:           tiers,
+		Scopes:          r.Scopes,
+		SubmissionRules: r.SubmissionRules.toDomain(),
+		RollingWindow:   time.Duration(r.RollingWindowHours) * time.Hour,
+		NotifyTop:       r.NotifyTop,
+		StatisticID:     r.StatisticID,
 	}
 }
 
@@ -53,17 +206,47 @@ func leaderboardFromDomain(l leaderboard.Leaderboard) Leaderboard {
 		endAt = &l.EndAt
 	}
 
+	rewardTiers := make([]RewardTier, len(l.RewardTiers))
+	for i, tier := range l.RewardTiers {
+		rewardTiers[i] = RewardTier{
+			Name:         tier.Name,
+			FromPosition: tier.FromPosition,
+			ToPosition:   tier.ToPosition,
+			Percentile:   tier.Percentile,
+		}
+	}
+
+	tiers := make([]Tier, len(l.Tiers))
+	for i, tier := range l.Tiers {
+		tiers[i] = Tier{
+			Name:       tier.Name,
+			Percentile: tier.Percentile,
+			Threshold:  tier.Threshold,
+		}
+	}
+
 	return Leaderboard{
-		CreatedAt:       l.CreatedAt,
-		UpdatedAt:       l.UpdatedAt,
-		ID:              l.ID,
-		GameID:          l.GameID,
-		Name:            l.Name,
-		Description:     l.Description,
-		StartAt:         l.StartAt,
-		EndAt:           endAt,
-		AggregationMode: l.AggregationMode,
-		Ordering:        l.Ordering,
+		CreatedAt:          l.CreatedAt,
+		UpdatedAt:          l.UpdatedAt,
+		ID:                 l.ID,
+		GameID:             l.GameID,
+		Name:               l.Name,
+		Description:        l.Description,
+		StartAt:            l.StartAt,
+		EndAt:              endAt,
+		AggregationMode:    l.AggregationMode,
+		Ordering:           l.Ordering,
+		RankingMode:        l.RankingMode,
+		TieBreakPolicy:     l.TieBreakPolicy,
+		Recurrence:         l.Recurrence,
+		Timezone:           l.Timezone,
+		RewardTiers:        rewardTiers,
+		Tiers:              tiers,
+		Scopes:             l.Scopes,
+		SubmissionRules:    submissionRulesFromDomain(l.SubmissionRules),
+		RollingWindowHours: int64(l.RollingWindow / time.Hour),
+		NotifyTop:          l.NotifyTop,
+		StatisticID:        l.StatisticID,
 	}
 }
 
@@ -71,14 +254,30 @@ var (
 	ErrorResponseLeaderboardInvalid   = ErrorResponse{Code: "1.0", Message: "Invalid leaderboard"}
 	ErrorResponseLeaderboardNotFound  = ErrorResponse{Code: "1.1", Message: "Leaderboard not found"}
 	ErrorResponseLeaderboardInvalidID = ErrorResponse{Code: "1.2", Message: "Invalid leaderboard ID"}
+	ErrorResponseLeaderboardStatus    = ErrorResponse{Code: "1.3", Message: "Invalid leaderboard status"}
 )
 
+func buildGetLeaderboardCacheKey(id, gameID string) string {
+	return fmt.Sprintf("GetLeaderboardMiddleware:%s:%s", id, gameID)
+}
+
+// Removes the leaderboard cached by the middleware, so the ranking routes don't keep using it after a change
+func evictLeaderboardCache(cache fiber.Storage, id, gameID string) {
+	if cache == nil {
+		return
+	}
+
+	if err := cache.Delete(buildGetLeaderboardCacheKey(id, gameID)); err != nil {
+		zap.Error(err, "unable to evict cached leaderboard")
+	}
+}
+
 func buildGetLeaderboardMiddleware(cache fiber.Storage, expiration time.Duration, getLeaderboardByIDAndGameIDFunc leaderboard.GetByIDAndGameIDFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var (
 			id       = c.Params("leaderboardId")
 			claims   = c.Locals("claims").(auth.Claims)
-			cacheKey = fmt.Sprintf("GetLeaderboardMiddleware:%s:%s", id, claims.GameID)
+			cacheKey = buildGetLeaderboardCacheKey(id, claims.GameID)
 		)
 
 		if cache != nil {
@@ -144,6 +343,36 @@ func buildCreateLeaderboardHandler(createLeaderboardFunc leaderboard.CreateFunc)
 	}
 }
 
+// @summary List Leaderboards
+// @description List the game's leaderboards paginated, most recent first. Leaderboards that didn't start yet are active
+// @router /api/v1/leaderboards [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param status query string false "Leaderboard status. Lists every leaderboard that's not deleted when empty" enums(ACTIVE,CLOSED,DELETED)
+// @param search query string false "Text that the leaderboard name must contain, ignoring the case"
+// @param page query int false "Page number" minimun(0) default(0)
+// @param limit query int false "Number of leaderboards per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} Leaderboards
+// @failure 422,500 {object} ErrorResponse
+func buildListLeaderboardsHandler(listLeaderboardsFunc leaderboard.ListFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			claims = c.Locals("claims").(auth.Claims)
+			status = c.Query("status")
+			search = c.Query("search")
+			page   = c.QueryInt("page", 0)
+			limit  = c.QueryInt("limit", 10)
+		)
+
+		leaderboards, err := listLeaderboardsFunc(c.Context(), claims.GameID, status, search, int64(page), int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(leaderboardsFromDomain(leaderboards))
+	}
+}
+
 // @summary Get Leaderboard
 // @description Return a leaderboard by id and game id
 // @router /api/v1/leaderboards/{leaderboardId} [GET]
@@ -168,6 +397,39 @@ func buildGetLeaderboardHandler(getLeaderboardByIDAndGameIDFunc leaderboard.GetB
 	}
 }
 
+// @summary Update Leaderboard
+// @description Update the leaderboard name, description and end time. Changing the end time reschedules the finalization of the period in progress
+// @router /api/v1/leaderboards/{leaderboardId} [PATCH]
+// @accept json
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param UpdateLeaderboardData body UpdateLeaderboardReq true "Leaderboard fields to update"
+// @success 200 {object} Leaderboard
+// @failure 400,404,422,500 {object} ErrorResponse
+func buildUpdateLeaderboardHandler(cache fiber.Storage, updateLeaderboardFunc leaderboard.UpdateFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			id     = c.Params("leaderboardId")
+			claims = c.Locals("claims").(auth.Claims)
+		)
+
+		var body UpdateLeaderboardReq
+		if err := c.BodyParser(&body); err != nil {
+			return err
+		}
+
+		leaderboard, err := updateLeaderboardFunc(c.Context(), id, claims.GameID, body.toDomain())
+		if err != nil {
+			return err
+		}
+
+		evictLeaderboardCache(cache, id, claims.GameID)
+
+		return c.Status(http.StatusOK).JSON(leaderboardFromDomain(leaderboard))
+	}
+}
+
 // @summary Delete Leaderboard
 // @description Delete a leaderboard by id and game id
 // @router /api/v1/leaderboards/{leaderboardId} [DELETE]
@@ -175,7 +437,7 @@ func buildGetLeaderboardHandler(getLeaderboardByIDAndGameIDFunc leaderboard.GetB
 // @param leaderboardId path string true "Leaderboard ID"
 // @success 204
 // @failure 404,422,500 {object} ErrorResponse
-func buildDeleteLeaderboardHandler(deleteLeaderboardByIDAndGameIDFunc leaderboard.SoftDeleteFunc) fiber.Handler {
+func buildDeleteLeaderboardHandler(cache fiber.Storage, deleteLeaderboardByIDAndGameIDFunc leaderboard.SoftDeleteFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var (
 			id     = c.Params("leaderboardId")
@@ -186,6 +448,34 @@ func buildDeleteLeaderboardHandler(deleteLeaderboardByIDAndGameIDFunc leaderboar
 			return err
 		}
 
+		evictLeaderboardCache(cache, id, claims.GameID)
+
 		return c.SendStatus(http.StatusNoContent)
 	}
 }
+
+// @summary Restore Leaderboard
+// @description Restore a deleted leaderboard by id and game id. Leaderboards are permanently removed once the deletion retention period is over
+// @router /api/v1/leaderboards/{leaderboardId}/restore [POST]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @success 200 {object} Leaderboard
+// @failure 404,422,500 {object} ErrorResponse
+func buildRestoreLeaderboardHandler(cache fiber.Storage, restoreLeaderboardFunc leaderboard.RestoreFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			id     = c.Params("leaderboardId")
+			claims = c.Locals("claims").(auth.Claims)
+		)
+
+		leaderboard, err := restoreLeaderboardFunc(c.Context(), id, claims.GameID)
+		if err != nil {
+			return err
+		}
+
+		evictLeaderboardCache(cache, id, claims.GameID)
+
+		return c.Status(http.StatusOK).JSON(leaderboardFromDomain(leaderboard))
+	}
+}
@@ -96,6 +96,7 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 		endAt           = time.Now().Add(24 * time.Hour).Format(time.RFC3339)
 		aggregationMode = "MAX"
 		ordering        = "DESC"
+		tieBreakPolicy  = "EARLIEST"
 	)
 
 	t.Run("OK", func(t *testing.T) {
@@ -113,8 +114,13 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 					EndAt:           data.EndAt,
 					AggregationMode: data.AggregationMode,
 					Ordering:        data.Ordering,
+					TieBreakPolicy:  data.TieBreakPolicy,
+					RewardTiers:     data.RewardTiers,
+					SubmissionRules: data.SubmissionRules,
 				}, nil
-			}),
+			}, func(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+				return nil
+			}, nil),
 		})
 
 		reqBody, err := json.Marshal(map[string]any{
@@ -124,6 +130,16 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 			"endAt":           endAt,
 			"aggregationMode": aggregationMode,
 			"ordering":        ordering,
+			"tieBreakPolicy":  tieBreakPolicy,
+			"rewardTiers": []map[string]any{
+				{"name": "Champion", "fromPosition": 0, "toPosition": 0},
+				{"name": "Top 5%", "percentile": 5},
+			},
+			"submissionRules": map[string]any{
+				"minValue":          0,
+				"maxSubmissions":    10,
+				"rateWindowSeconds": 60,
+			},
 		})
 		assert.NoError(t, err)
 
@@ -150,6 +166,13 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 		assert.Equal(t, endAt, data.EndAt.Format(time.RFC3339))
 		assert.Equal(t, aggregationMode, data.AggregationMode)
 		assert.Equal(t, ordering, data.Ordering)
+		assert.Equal(t, tieBreakPolicy, data.TieBreakPolicy)
+		assert.Len(t, data.RewardTiers, 2)
+		assert.Equal(t, float64(5), data.RewardTiers[1].Percentile)
+		assert.Equal(t, float64(0), *data.SubmissionRules.MinValue)
+		assert.Nil(t, data.SubmissionRules.MaxValue)
+		assert.Equal(t, int64(10), data.SubmissionRules.MaxSubmissions)
+		assert.Equal(t, int64(60), data.SubmissionRules.RateWindowSeconds)
 	})
 
 	t.Run("Validation Error", func(t *testing.T) {
@@ -159,7 +182,9 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 			},
 			CreateLeaderboardFunc: leaderboard.BuildCreateFunc(func(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{}, nil
-			}),
+			}, func(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+				return nil
+			}, nil),
 		})
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/leaderboards", bytes.NewBufferString(`{}`))
@@ -189,7 +214,9 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 			},
 			CreateLeaderboardFunc: leaderboard.BuildCreateFunc(func(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: uuid.NewString()}, nil
-			}),
+			}, func(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+				return nil
+			}, nil),
 		})
 
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/leaderboards", bytes.NewBufferString(`{`))
@@ -221,7 +248,9 @@ func TestBuildCreateLeaderboardHandler(t *testing.T) {
 			},
 			CreateLeaderboardFunc: leaderboard.BuildCreateFunc(func(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{}, errors.New("any error")
-			}),
+			}, func(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+				return nil
+			}, nil),
 		})
 
 		reqBody, err := json.Marshal(map[string]any{
@@ -377,6 +406,306 @@ func TestBuildGetLeaderboardHandler(t *testing.T) {
 	})
 }
 
+func TestBuildListLeaderboardsHandler(t *testing.T) {
+	expectedGameID := uuid.NewString()
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListLeaderboardsFunc: leaderboard.BuildListFunc(func(ctx context.Context, gameID string, filter leaderboard.LeaderboardsFilter, page, limit int64) (leaderboard.Leaderboards, error) {
+				assert.Equal(t, expectedGameID, gameID)
+				assert.Equal(t, leaderboard.LeaderboardsFilter{Status: leaderboard.StatusActive, Search: "race"}, filter)
+				assert.Equal(t, int64(0), page)
+				assert.Equal(t, int64(1), limit)
+				return leaderboard.Leaderboards{
+					Total:        2,
+					Leaderboards: []leaderboard.Leaderboard{{ID: uuid.NewString(), GameID: gameID, Name: "Weekly Race"}},
+				}, nil
+			}),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leaderboards?status=ACTIVE&search=race&page=0&limit=1", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Leaderboards
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(2), data.Total)
+		assert.Len(t, data.Leaderboards, 1)
+		assert.Equal(t, "Weekly Race", data.Leaderboards[0].Name)
+	})
+
+	t.Run("Invalid Status", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListLeaderboardsFunc: leaderboard.BuildListFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leaderboards?status=INVALID", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardStatus.Code, data.Code)
+		assert.Equal(t, ErrorResponseLeaderboardStatus.Message, data.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListLeaderboardsFunc: leaderboard.BuildListFunc(func(ctx context.Context, gameID string, filter leaderboard.LeaderboardsFilter, page, limit int64) (leaderboard.Leaderboards, error) {
+				return leaderboard.Leaderboards{}, errors.New("any error")
+			}),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leaderboards", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, data.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
+	})
+}
+
+func TestBuildUpdateLeaderboardHandler(t *testing.T) {
+	var (
+		expectedID     = uuid.NewString()
+		expectedGameID = uuid.NewString()
+		current        = leaderboard.Leaderboard{
+			ID:              expectedID,
+			GameID:          expectedGameID,
+			Name:            "Old Leaderboard",
+			Description:     "Test update leaderboard request",
+			StartAt:         time.Now().Add(-time.Hour),
+			EndAt:           time.Now().Add(24 * time.Hour),
+			AggregationMode: leaderboard.AggregationModeMax,
+			Ordering:        leaderboard.OrderingDesc,
+			RankingMode:     leaderboard.RankingModeOrdinal,
+			TieBreakPolicy:  leaderboard.TieBreakPolicyNone,
+			Recurrence:      leaderboard.RecurrenceNone,
+			Timezone:        "UTC",
+		}
+	)
+
+	buildUpdateFunc := func(getErr error) leaderboard.UpdateFunc {
+		return leaderboard.BuildUpdateFunc(func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+			return current, getErr
+		}, func(ctx context.Context, lb leaderboard.Leaderboard) (leaderboard.Leaderboard, error) {
+			return lb, nil
+		}, func(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+			return nil
+		}, func(ctx context.Context, finalization leaderboard.Finalization) error {
+			return nil
+		}, nil)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(nil),
+		})
+
+		reqBody, err := json.Marshal(map[string]any{"name": "Test Leaderboard", "removeEndAt": true})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewReader(reqBody))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Leaderboard
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expectedID, data.ID)
+		assert.Equal(t, "Test Leaderboard", data.Name)
+		assert.Equal(t, current.Description, data.Description)
+		assert.Nil(t, data.EndAt)
+	})
+
+	t.Run("Evict Cached Leaderboard", func(t *testing.T) {
+		cache := cacheStorage{buildGetLeaderboardCacheKey(expectedID, expectedGameID): []byte("{}")}
+
+		app := App(Config{
+			CacheSorage: cache,
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewBufferString(`{"name":"Test Leaderboard"}`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, cache)
+	})
+
+	t.Run("Invalid Body", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewReader([]byte("{")))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Code, data.Code)
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Message, data.Message)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(nil),
+		})
+
+		reqBody, err := json.Marshal(map[string]any{"name": "", "endAt": current.StartAt.Add(-time.Hour)})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewReader(reqBody))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardInvalid.Code, data.Code)
+		assert.Equal(t, ErrorResponseLeaderboardInvalid.Message, data.Message)
+		assert.Contains(t, data.Details, leaderboard.ErrInvalidName.Error())
+		assert.Contains(t, data.Details, leaderboard.ErrEndDateBeforeStartDate.Error())
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(leaderboard.ErrLeaderboardNotFound),
+		})
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewReader([]byte("{}")))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Code, data.Code)
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Message, data.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			UpdateLeaderboardFunc: buildUpdateFunc(errors.New("any error")),
+		})
+
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), bytes.NewReader([]byte("{}")))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, data.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
+	})
+}
+
 func TestBuildDeleteLeaderboardHandler(t *testing.T) {
 	var (
 		expectedID     = uuid.NewString()
@@ -404,6 +733,29 @@ func TestBuildDeleteLeaderboardHandler(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 	})
 
+	t.Run("Evict Cached Leaderboard", func(t *testing.T) {
+		cache := cacheStorage{buildGetLeaderboardCacheKey(expectedID, expectedGameID): []byte("{}")}
+
+		app := App(Config{
+			CacheSorage: cache,
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			DeleteLeaderboardByIDAndGameIDFunc: leaderboard.BuildSoftDeleteFunc(func(ctx context.Context, id, gameID string) error {
+				return nil
+			}),
+		})
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/leaderboards/%s", expectedID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Empty(t, cache)
+	})
+
 	t.Run("Not Found", func(t *testing.T) {
 		app := App(Config{
 			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
@@ -463,3 +815,144 @@ func TestBuildDeleteLeaderboardHandler(t *testing.T) {
 		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
 	})
 }
+
+func TestBuildRestoreLeaderboardHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreLeaderboardFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/restore", leaderboardID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Leaderboard
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, leaderboardID, data.ID)
+		assert.Equal(t, gameID, data.GameID)
+	})
+
+	t.Run("Evict Cached Leaderboard", func(t *testing.T) {
+		cache := cacheStorage{buildGetLeaderboardCacheKey(leaderboardID, gameID): []byte("{}")}
+
+		app := App(Config{
+			CacheSorage: cache,
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreLeaderboardFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/restore", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, cache)
+	})
+
+	t.Run("Invalid Leaderboard ID", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreLeaderboardFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{}, leaderboard.ErrInvalidLeaderboardID
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/leaderboards/invalid-id/restore", nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardInvalidID.Code, data.Code)
+		assert.Equal(t, ErrorResponseLeaderboardInvalidID.Message, data.Message)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreLeaderboardFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{}, leaderboard.ErrLeaderboardNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/restore", leaderboardID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Code, data.Code)
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Message, data.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreLeaderboardFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/restore", leaderboardID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, data.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
+	})
+}
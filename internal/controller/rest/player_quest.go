@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gabapcia/gameblitz/internal/auth"
 	"github.com/gabapcia/gameblitz/internal/quest"
 
 	"github.com/gofiber/fiber/v2"
@@ -26,9 +27,26 @@ type (
 		UpdatedAt        time.Time                    `json:"updatedAt"`             // Last time the player updated the quest progression
 		PlayerID         string                       `json:"playerId"`              // Player's ID
 		Quest            Quest                        `json:"quest"`                 // Quest Config Data
+		QuestVersion     int                          `json:"questVersion"`          // Quest version the player is progressing on
 		CompletedAt      *time.Time                   `json:"completedAt,omitempty"` // Time the player completed the quest
 		TasksProgression []PlayerQuestTaskProgression `json:"tasksProgression"`      // Tasks progression
 	}
+
+	PlayerQuestSummary struct {
+		StartedAt      time.Time  `json:"startedAt"`             // Time the player started the quest
+		UpdatedAt      time.Time  `json:"updatedAt"`             // Last time the player updated the quest progression
+		Quest          Quest      `json:"quest"`                 // Quest config data, without its tasks
+		QuestVersion   int        `json:"questVersion"`          // Quest version the player is progressing on
+		CompletedAt    *time.Time `json:"completedAt,omitempty"` // Time the player completed the quest
+		TasksCompleted int64      `json:"tasksCompleted"`        // Number of the quest tasks completed by the player
+		TasksTotal     int64      `json:"tasksTotal"`            // Number of tasks of the quest
+	}
+
+	PlayerQuests struct {
+		PlayerID string               `json:"playerId"` // Player's ID
+		Total    int64                `json:"total"`    // Number of quests started by the player
+		Quests   []PlayerQuestSummary `json:"quests"`   // Player quests page, most recently started first
+	}
 )
 
 func playerQuestProgressionFromDomain(p quest.PlayerQuestProgression) PlayerQuestProgression {
@@ -58,11 +76,39 @@ func playerQuestProgressionFromDomain(p quest.PlayerQuestProgression) PlayerQues
 		UpdatedAt:        p.UpdatedAt,
 		PlayerID:         p.PlayerID,
 		Quest:            questFromDomain(p.Quest),
+		QuestVersion:     p.QuestVersion,
 		CompletedAt:      completedAt,
 		TasksProgression: tasksProgression,
 	}
 }
 
+func playerQuestsFromDomain(playerID string, p quest.PlayerQuests) PlayerQuests {
+	quests := make([]PlayerQuestSummary, len(p.Quests))
+	for i, pq := range p.Quests {
+		var completedAt *time.Time
+		if !pq.CompletedAt.IsZero() {
+			tmp := pq.CompletedAt
+			completedAt = &tmp
+		}
+
+		quests[i] = PlayerQuestSummary{
+			StartedAt:      pq.StartedAt,
+			UpdatedAt:      pq.UpdatedAt,
+			Quest:          questFromDomain(pq.Quest),
+			QuestVersion:   pq.QuestVersion,
+			CompletedAt:    completedAt,
+			TasksCompleted: pq.TasksCompleted,
+			TasksTotal:     pq.TasksTotal,
+		}
+	}
+
+	return PlayerQuests{
+		PlayerID: playerID,
+		Total:    p.Total,
+		Quests:   quests,
+	}
+}
+
 var (
 	ErrorResponsePlayerAlreadyStartedTheQuest = ErrorResponse{Code: "6.0", Message: "Player already started the quest"}
 	ErrorResponsePlayerNotStartedTheQuest     = ErrorResponse{Code: "6.1", Message: "Player not started the quest"}
@@ -94,6 +140,34 @@ func buildStartPlayerQuestHandler(startQuestForPlayerFunc quest.StartQuestForPla
 	}
 }
 
+// @summary List Player Quests
+// @description List the game's quests started by the player paginated, most recently started first, with how many of their tasks were completed. Deleted quests are skipped
+// @router /api/v1/players/{playerId}/quests [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param playerId path string true "Player ID"
+// @param page query int false "Page number" minimun(0) default(0)
+// @param limit query int false "Number of quests per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} PlayerQuests
+// @failure 422,500 {object} ErrorResponse
+func buildListPlayerQuestsHandler(listPlayerQuestsFunc quest.ListPlayerQuestsFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			claims   = c.Locals("claims").(auth.Claims)
+			playerID = c.Params("playerId")
+			page     = c.QueryInt("page", 0)
+			limit    = c.QueryInt("limit", 10)
+		)
+
+		quests, err := listPlayerQuestsFunc(c.Context(), claims.GameID, playerID, int64(page), int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(playerQuestsFromDomain(playerID, quests))
+	}
+}
+
 // @summary Get Player Quest Progression
 // @description Get a player's quest progression
 // @router /api/v1/quests/{questId}/players/{playerId} [GET]
@@ -150,3 +224,28 @@ func buildUpdatePlayerQuestProgressionHandler(updatePlayerQuestProgressionFunc q
 		return c.Status(http.StatusOK).JSON(playerQuestProgressionFromDomain(progression))
 	}
 }
+
+// @summary Migrate Player Quest Progression
+// @description Move a player's quest progression to the latest quest version. Tasks keep their progression by their key
+// @router /api/v1/quests/{questId}/players/{playerId}/migrate [POST]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param questId path string true "Quest ID"
+// @param playerId path string true "Player ID"
+// @success 200 {object} PlayerQuestProgression
+// @failure 404,422,500 {object} ErrorResponse
+func buildMigratePlayerQuestHandler(migratePlayerQuestFunc quest.MigratePlayerQuestFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			quest    = c.Locals("quest").(quest.Quest)
+			playerID = c.Params("playerId")
+		)
+
+		progression, err := migratePlayerQuestFunc(c.Context(), quest, playerID)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(playerQuestProgressionFromDomain(progression))
+	}
+}
@@ -571,3 +571,282 @@ func TestBuildUpdatePlayerQuestProgressionHandler(t *testing.T) {
 		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
 	})
 }
+
+func TestBuildListPlayerQuestsHandler(t *testing.T) {
+	var (
+		expectedGameID   = uuid.NewString()
+		expectedPlayerID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		completedAt := time.Now()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListPlayerQuestsFunc: func(ctx context.Context, gameID, playerID string, page, limit int64) (quest.PlayerQuests, error) {
+				assert.Equal(t, expectedGameID, gameID)
+				assert.Equal(t, expectedPlayerID, playerID)
+				assert.Equal(t, int64(0), page)
+				assert.Equal(t, int64(10), limit)
+
+				return quest.PlayerQuests{
+					Total: 2,
+					Quests: []quest.PlayerQuestSummary{
+						{PlayerID: playerID, Quest: quest.Quest{ID: uuid.NewString()}, TasksCompleted: 1, TasksTotal: 3},
+						{PlayerID: playerID, Quest: quest.Quest{ID: uuid.NewString()}, CompletedAt: completedAt, TasksCompleted: 2, TasksTotal: 2},
+					},
+				}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/players/%s/quests", expectedPlayerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data PlayerQuests
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expectedPlayerID, data.PlayerID)
+		assert.Equal(t, int64(2), data.Total)
+		assert.Len(t, data.Quests, 2)
+		assert.Nil(t, data.Quests[0].CompletedAt)
+		assert.Equal(t, int64(1), data.Quests[0].TasksCompleted)
+		assert.Equal(t, int64(3), data.Quests[0].TasksTotal)
+		assert.NotNil(t, data.Quests[1].CompletedAt)
+	})
+
+	t.Run("Invalid Page", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListPlayerQuestsFunc: quest.BuildListPlayerQuestsFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/players/%s/quests?page=-1", expectedPlayerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestPageNumber, data)
+	})
+
+	t.Run("Unknown Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListPlayerQuestsFunc: func(ctx context.Context, gameID, playerID string, page, limit int64) (quest.PlayerQuests, error) {
+				return quest.PlayerQuests{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/players/%s/quests", expectedPlayerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError, data)
+	})
+}
+
+func TestBuildMigratePlayerQuestHandler(t *testing.T) {
+	var (
+		questID  = uuid.NewString()
+		gameID   = uuid.NewString()
+		playerID = uuid.NewString()
+
+		expectedQuest = quest.Quest{
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+			ID:          questID,
+			GameID:      gameID,
+			Name:        "Test Quest",
+			Description: "Migrate player quest handler unit test",
+			Version:     2,
+			Tasks: []quest.Task{
+				{
+					CreatedAt:             time.Now(),
+					UpdatedAt:             time.Now(),
+					ID:                    uuid.NewString(),
+					Key:                   "0",
+					Name:                  "Test Task",
+					Description:           "Migrate player quest handler unit test",
+					DependsOn:             make([]string, 0),
+					RequiredForCompletion: true,
+					Rule:                  `{"==": [{"var": "fields.bool"}, true]}`,
+				},
+			},
+		}
+
+		expectedPlayerProgression = quest.PlayerQuestProgression{
+			StartedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+			PlayerID:     playerID,
+			Quest:        expectedQuest,
+			QuestVersion: expectedQuest.Version,
+			TasksProgression: []quest.PlayerTaskProgression{
+				{
+					StartedAt: time.Now(),
+					UpdatedAt: time.Now(),
+					Task:      expectedQuest.Tasks[0],
+				},
+			},
+		}
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetQuestByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return expectedQuest, nil
+			},
+			MigratePlayerQuestFunc: func(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
+				return expectedPlayerProgression, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/players/%s/migrate", questID, playerID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body PlayerQuestProgression
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, expectedPlayerProgression.PlayerID, body.PlayerID)
+		assert.Equal(t, expectedQuest.Version, body.QuestVersion)
+		assert.Len(t, body.TasksProgression, 1)
+		assert.Equal(t, expectedQuest.Tasks[0].Key, body.TasksProgression[0].Task.Key)
+	})
+
+	t.Run("Not Started", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetQuestByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return expectedQuest, nil
+			},
+			MigratePlayerQuestFunc: func(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
+				return quest.PlayerQuestProgression{}, quest.ErrPlayerNotStartedTheQuest
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/players/%s/migrate", questID, playerID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponsePlayerNotStartedTheQuest.Code, body.Code)
+		assert.Equal(t, ErrorResponsePlayerNotStartedTheQuest.Message, body.Message)
+	})
+
+	t.Run("Already Completed", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetQuestByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return expectedQuest, nil
+			},
+			MigratePlayerQuestFunc: func(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
+				return quest.PlayerQuestProgression{}, quest.ErrPlayerQuestAlreadyCompleted
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/players/%s/migrate", questID, playerID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponsePlayerQuestAlreadyFinished.Code, body.Code)
+		assert.Equal(t, ErrorResponsePlayerQuestAlreadyFinished.Message, body.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetQuestByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return expectedQuest, nil
+			},
+			MigratePlayerQuestFunc: func(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
+				return quest.PlayerQuestProgression{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/players/%s/migrate", questID, playerID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, body.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
+	})
+}
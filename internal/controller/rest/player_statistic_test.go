@@ -113,7 +113,7 @@ func TestTuildUpsertPlayerStatisticHandler(t *testing.T) {
 			GetStatisticByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (statistic.Statistic, error) {
 				return statistic.Statistic{ID: id, GameID: gameID}, nil
 			},
-			UpsertPlayerRankFunc: func(ctx context.Context, leaderboard leaderboard.Leaderboard, playerID string, value float64) error {
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
 				return errors.New("any error")
 			},
 		})
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -16,6 +17,7 @@ type CreateQuestReq struct {
 	Name        string `json:"name"`        // Quest name
 	Description string `json:"description"` // Quest details
 	Tasks       []struct {
+		Key                   string `json:"key"`                   // Task key that identifies it across the quest versions. Defaults to the task array index
 		Name                  string `json:"name"`                  // Task name
 		Description           string `json:"description"`           // Task details
 		DependsOn             []int  `json:"dependsOn"`             // List of array indexes of the tasks that needs to be completed before this one can be started
@@ -32,9 +34,15 @@ type Quest struct {
 	GameID      string    `json:"gameId"`      // ID of the game responsible for the quest
 	Name        string    `json:"name"`        // Quest name
 	Description string    `json:"description"` // Quest details
+	Version     int       `json:"version"`     // Quest version, increased on every update
 	Tasks       []Task    `json:"tasks"`       // Quest task list
 }
 
+type Quests struct {
+	Total  int64   `json:"total"`  // Number of quests that match the filters
+	Quests []Quest `json:"quests"` // Quests page, most recent first
+}
+
 func (q CreateQuestReq) toDomain(gameID string) quest.NewQuestData {
 	tasks := make([]quest.NewTaskData, len(q.Tasks))
 	for i, t := range q.Tasks {
@@ -44,6 +52,7 @@ func (q CreateQuestReq) toDomain(gameID string) quest.NewQuestData {
 		}
 
 		tasks[i] = quest.NewTaskData{
+			Key:                   t.Key,
 			Name:                  t.Name,
 			Description:           t.Description,
 			DependsOn:             t.DependsOn,
@@ -74,22 +83,53 @@ func questFromDomain(q quest.Quest) Quest {
 		GameID:      q.GameID,
 		Name:        q.Name,
 		Description: q.Description,
+		Version:     q.Version,
 		Tasks:       tasks,
 	}
 }
 
+func questsFromDomain(q quest.Quests) Quests {
+	quests := make([]Quest, len(q.Quests))
+	for i, qd := range q.Quests {
+		quests[i] = questFromDomain(qd)
+	}
+
+	return Quests{
+		Total:  q.Total,
+		Quests: quests,
+	}
+}
+
 var (
-	ErrorResponseQuestInvalid   = ErrorResponse{Code: "3.0", Message: "Invalid quest data"}
-	ErrorResponseQuestNotFound  = ErrorResponse{Code: "3.1", Message: "Quest not found"}
-	ErrorResponseQuestInvalidID = ErrorResponse{Code: "3.2", Message: "Invalid quest id"}
+	ErrorResponseQuestInvalid      = ErrorResponse{Code: "3.0", Message: "Invalid quest data"}
+	ErrorResponseQuestNotFound     = ErrorResponse{Code: "3.1", Message: "Quest not found"}
+	ErrorResponseQuestInvalidID    = ErrorResponse{Code: "3.2", Message: "Invalid quest id"}
+	ErrorResponseQuestPageNumber   = ErrorResponse{Code: "3.3", Message: "Invalid page number"}
+	ErrorResponseQuestLimitNumber  = ErrorResponse{Code: "3.4", Message: "Invalid limit number"}
+	ErrorResponseQuestCreatedRange = ErrorResponse{Code: "3.5", Message: "Invalid created range"}
 )
 
+func buildGetQuestCacheKey(id, gameID string) string {
+	return fmt.Sprintf("GetQuestMiddleware:%s:%s", id, gameID)
+}
+
+// Removes the quest cached by the middleware, so the players' routes don't keep using it after a change
+func evictQuestCache(cache fiber.Storage, id, gameID string) {
+	if cache == nil {
+		return
+	}
+
+	if err := cache.Delete(buildGetQuestCacheKey(id, gameID)); err != nil {
+		zap.Error(err, "unable to evict cached quest")
+	}
+}
+
 func buildGetQuestMiddleware(cache fiber.Storage, expiration time.Duration, getQuestByIDAndGameIDFunc quest.GetQuestByIDAndGameIDFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var (
 			id       = c.Params("questId")
 			claims   = c.Locals("claims").(auth.Claims)
-			cacheKey = fmt.Sprintf("GetQuestMiddleware:%s:%s", id, claims.GameID)
+			cacheKey = buildGetQuestCacheKey(id, claims.GameID)
 		)
 
 		if cache != nil {
@@ -155,6 +195,63 @@ func buildCreateQuestHanlder(createQuestFunc quest.CreateQuestFunc) fiber.Handle
 	}
 }
 
+// Parses an optional RFC 3339 query param. Returns the zero time when it's missing
+func queryTime(c *fiber.Ctx, key string) (time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, raw)
+}
+
+// @summary List Quests
+// @description List the game's quests paginated, most recent first
+// @router /api/v1/quests [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param deleted query bool false "List the deleted quests instead of the ones that are not deleted" default(false)
+// @param search query string false "Text that the quest name must contain, ignoring the case"
+// @param createdAfter query string false "Lists the quests created at or after this time, as RFC 3339" format(date-time)
+// @param createdBefore query string false "Lists the quests created before this time, as RFC 3339" format(date-time)
+// @param page query int false "Page number" minimun(0) default(0)
+// @param limit query int false "Number of quests per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} Quests
+// @failure 422,500 {object} ErrorResponse
+func buildListQuestsHandler(listQuestsFunc quest.ListQuestsFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			claims = c.Locals("claims").(auth.Claims)
+			page   = c.QueryInt("page", 0)
+			limit  = c.QueryInt("limit", 10)
+		)
+
+		createdAfter, err := queryTime(c, "createdAfter")
+		if err != nil {
+			return errors.Join(quest.ErrInvalidCreatedRange, err)
+		}
+
+		createdBefore, err := queryTime(c, "createdBefore")
+		if err != nil {
+			return errors.Join(quest.ErrInvalidCreatedRange, err)
+		}
+
+		filter := quest.QuestsFilter{
+			Deleted:       c.QueryBool("deleted", false),
+			Search:        c.Query("search"),
+			CreatedAfter:  createdAfter,
+			CreatedBefore: createdBefore,
+		}
+
+		quests, err := listQuestsFunc(c.Context(), claims.GameID, filter, int64(page), int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(questsFromDomain(quests))
+	}
+}
+
 // @summary Get Quest By ID
 // @description Get a quest and its tasks
 // @router /api/v1/quests/{questId} [GET]
@@ -179,6 +276,39 @@ func buildGetQuestHanlder(getQuestByIDAndGameID quest.GetQuestByIDAndGameIDFunc)
 	}
 }
 
+// @summary Update Quest
+// @description Create a new version of the quest with the given config data. Players that already started the quest keep progressing on their version until they're migrated
+// @router /api/v1/quests/{questId} [PUT]
+// @accept json
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param questId path string true "Quest ID"
+// @param QuestData body CreateQuestReq true "Quest config data"
+// @success 200 {object} Quest
+// @failure 400,404,422,500 {object} ErrorResponse
+func buildUpdateQuestHandler(cache fiber.Storage, updateQuestFunc quest.UpdateQuestFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			questID = c.Params("questId")
+			claims  = c.Locals("claims").(auth.Claims)
+		)
+
+		var body CreateQuestReq
+		if err := c.BodyParser(&body); err != nil {
+			return err
+		}
+
+		quest, err := updateQuestFunc(c.Context(), questID, body.toDomain(claims.GameID))
+		if err != nil {
+			return err
+		}
+
+		evictQuestCache(cache, questID, claims.GameID)
+
+		return c.Status(http.StatusOK).JSON(questFromDomain(quest))
+	}
+}
+
 // @summary Delete Quest
 // @description Delete a quest and its tasks
 // @router /api/v1/quests/{questId} [DELETE]
@@ -186,7 +316,7 @@ func buildGetQuestHanlder(getQuestByIDAndGameID quest.GetQuestByIDAndGameIDFunc)
 // @param questId path string true "Quest ID"
 // @success 204
 // @failure 404,422,500 {object} ErrorResponse
-func buildDeleteQuestHanlder(softDeleteQuestFunc quest.SoftDeleteQuestFunc) fiber.Handler {
+func buildDeleteQuestHanlder(cache fiber.Storage, softDeleteQuestFunc quest.SoftDeleteQuestFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var (
 			questID = c.Params("questId")
@@ -197,6 +327,34 @@ func buildDeleteQuestHanlder(softDeleteQuestFunc quest.SoftDeleteQuestFunc) fibe
 			return err
 		}
 
+		evictQuestCache(cache, questID, claims.GameID)
+
 		return c.SendStatus(http.StatusNoContent)
 	}
 }
+
+// @summary Restore Quest
+// @description Restore a deleted quest and the tasks deleted with it. Quests are permanently removed once the deletion retention period is over
+// @router /api/v1/quests/{questId}/restore [POST]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param questId path string true "Quest ID"
+// @success 200 {object} Quest
+// @failure 404,422,500 {object} ErrorResponse
+func buildRestoreQuestHanlder(cache fiber.Storage, restoreQuestFunc quest.RestoreQuestFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			questID = c.Params("questId")
+			claims  = c.Locals("claims").(auth.Claims)
+		)
+
+		quest, err := restoreQuestFunc(c.Context(), questID, claims.GameID)
+		if err != nil {
+			return err
+		}
+
+		evictQuestCache(cache, questID, claims.GameID)
+
+		return c.Status(http.StatusOK).JSON(questFromDomain(quest))
+	}
+}
@@ -20,6 +20,32 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// In memory cache storage, so the tests can check what's cached
+type cacheStorage map[string][]byte
+
+func (s cacheStorage) Get(key string) ([]byte, error) {
+	return s[key], nil
+}
+
+func (s cacheStorage) Set(key string, val []byte, exp time.Duration) error {
+	s[key] = val
+	return nil
+}
+
+func (s cacheStorage) Delete(key string) error {
+	delete(s, key)
+	return nil
+}
+
+func (s cacheStorage) Reset() error {
+	clear(s)
+	return nil
+}
+
+func (s cacheStorage) Close() error {
+	return nil
+}
+
 func TestBuildGetQuestMiddleware(t *testing.T) {
 	var (
 		questID = uuid.NewString()
@@ -243,6 +269,192 @@ func TestBuildCreateQuestHanlder(t *testing.T) {
 	})
 }
 
+func TestBuildUpdateQuestHandler(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		var (
+			questID = uuid.NewString()
+			gameID  = uuid.NewString()
+		)
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			UpdateQuestFunc: func(ctx context.Context, id string, data quest.NewQuestData) (quest.Quest, error) {
+				tasks := make([]quest.Task, len(data.Tasks))
+				for i, task := range data.Tasks {
+					tasks[i] = quest.Task{ID: uuid.NewString(), Key: task.Key}
+				}
+
+				return quest.Quest{
+					ID:      id,
+					GameID:  data.GameID,
+					Version: 2,
+					Tasks:   tasks,
+				}, nil
+			},
+		})
+
+		body, err := json.Marshal(map[string]any{
+			"name":        "Test Update Quest",
+			"description": "Test update quest handler unit test",
+			"tasks": []map[string]any{
+				{
+					"key":         "first",
+					"name":        "Test Task #0",
+					"description": "Test task description",
+					"rule":        `{"==": [{"var": {"fields.bool"}}, true]}`,
+				},
+			},
+			"tasksValidators": []string{
+				`{"fields": {"bool": true}}`,
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/quests/%s", questID), bytes.NewBuffer(body))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Quest
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, questID, data.ID)
+		assert.Equal(t, gameID, data.GameID)
+		assert.Equal(t, 2, data.Version)
+		assert.Len(t, data.Tasks, 1)
+		assert.Equal(t, "first", data.Tasks[0].Key)
+	})
+
+	t.Run("Evict Cached Quest", func(t *testing.T) {
+		var (
+			questID = uuid.NewString()
+			gameID  = uuid.NewString()
+			cache   = cacheStorage{buildGetQuestCacheKey(questID, gameID): []byte("{}")}
+		)
+
+		app := App(Config{
+			CacheSorage: cache,
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			UpdateQuestFunc: func(ctx context.Context, id string, data quest.NewQuestData) (quest.Quest, error) {
+				return quest.Quest{ID: id, GameID: data.GameID, Version: 2}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/quests/%s", questID), bytes.NewBufferString("{}"))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, cache)
+	})
+
+	t.Run("Quest Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: uuid.NewString()}, nil
+			},
+			UpdateQuestFunc: func(ctx context.Context, id string, data quest.NewQuestData) (quest.Quest, error) {
+				return quest.Quest{}, quest.ErrQuestNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/quests/%s", uuid.NewString()), bytes.NewBufferString("{}"))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestNotFound.Code, data.Code)
+		assert.Equal(t, ErrorResponseQuestNotFound.Message, data.Message)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: uuid.NewString()}, nil
+			},
+			UpdateQuestFunc: quest.BuildUpdateQuestFunc(nil),
+		})
+
+		body, err := json.Marshal(map[string]any{
+			"tasks": []map[string]any{
+				{
+					"key":  "same",
+					"rule": `{"==": [{"var": "fields.bool"}, true]}`,
+				},
+				{
+					"key":  "same",
+					"rule": `{"==": [{"var": "fields.bool"}, true]}`,
+				},
+			},
+			"tasksValidators": []string{
+				`{"fields": {"bool": true}}`,
+				`{"fields": {"bool": true}}`,
+			},
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/quests/%s", uuid.NewString()), bytes.NewBuffer(body))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestInvalid.Code, data.Code)
+		assert.Equal(t, ErrorResponseQuestInvalid.Message, data.Message)
+	})
+
+	t.Run("Invalid Request Body", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: uuid.NewString()}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/quests/%s", uuid.NewString()), bytes.NewBufferString("{"))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Code, data.Code)
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Message, data.Message)
+	})
+}
+
 func TestBuildGetQuestHanlder(t *testing.T) {
 	var (
 		questID = uuid.NewString()
@@ -387,6 +599,29 @@ func TestBuildDeleteQuestHanlder(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 	})
 
+	t.Run("Evict Cached Quest", func(t *testing.T) {
+		cache := cacheStorage{buildGetQuestCacheKey(questID, gameID): []byte("{}")}
+
+		app := App(Config{
+			CacheSorage: cache,
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			SoftDeleteQuestFunc: func(ctx context.Context, questID, gameID string) error {
+				return nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/quests/%s", questID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Empty(t, cache)
+	})
+
 	t.Run("Invalid Quest ID", func(t *testing.T) {
 		app := App(Config{
 			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
@@ -471,3 +706,216 @@ func TestBuildDeleteQuestHanlder(t *testing.T) {
 		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
 	})
 }
+
+func TestBuildRestoreQuestHanlder(t *testing.T) {
+	var (
+		questID = uuid.NewString()
+		gameID  = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreQuestFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return quest.Quest{ID: id, GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/restore", questID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Quest
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, questID, data.ID)
+		assert.Equal(t, gameID, data.GameID)
+	})
+
+	t.Run("Invalid Quest ID", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreQuestFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return quest.Quest{}, quest.ErrInvalidQuestID
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/quests/invalid-id/restore", nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestInvalidID.Code, data.Code)
+		assert.Equal(t, ErrorResponseQuestInvalidID.Message, data.Message)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreQuestFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return quest.Quest{}, quest.ErrQuestNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/restore", questID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestNotFound.Code, data.Code)
+		assert.Equal(t, ErrorResponseQuestNotFound.Message, data.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			RestoreQuestFunc: func(ctx context.Context, id, gameID string) (quest.Quest, error) {
+				return quest.Quest{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/quests/%s/restore", questID), nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, data.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, data.Message)
+	})
+}
+
+func TestBuildListQuestsHandler(t *testing.T) {
+	expectedGameID := uuid.NewString()
+
+	t.Run("OK", func(t *testing.T) {
+		createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListQuestsFunc: func(ctx context.Context, gameID string, filter quest.QuestsFilter, page, limit int64) (quest.Quests, error) {
+				assert.Equal(t, expectedGameID, gameID)
+				assert.Equal(t, quest.QuestsFilter{Deleted: true, Search: "dragon", CreatedAfter: createdAfter}, filter)
+				assert.Equal(t, int64(1), page)
+				assert.Equal(t, int64(2), limit)
+
+				return quest.Quests{
+					Total: 3,
+					Quests: []quest.Quest{
+						{ID: uuid.NewString(), GameID: gameID, Name: "Slay the dragon", Tasks: []quest.Task{{ID: uuid.NewString()}}},
+					},
+				}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/quests?deleted=true&search=dragon&createdAfter=2024-01-01T00:00:00Z&page=1&limit=2", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Quests
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(3), data.Total)
+		assert.Len(t, data.Quests, 1)
+		assert.Equal(t, "Slay the dragon", data.Quests[0].Name)
+		assert.Len(t, data.Quests[0].Tasks, 1)
+	})
+
+	t.Run("Invalid Created Time", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/quests?createdBefore=2024-01-01", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestCreatedRange, data)
+	})
+
+	t.Run("Invalid Limit", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: expectedGameID}, nil
+			},
+			ListQuestsFunc: quest.BuildListQuestsFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/quests?limit=501", nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseQuestLimitNumber, data)
+	})
+}
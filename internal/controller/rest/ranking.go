@@ -1,37 +1,260 @@
 package rest
 
 import (
+	"errors"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/gabapcia/gameblitz/internal/auth"
+	"github.com/gabapcia/gameblitz/internal/infra/logger/zap"
 	"github.com/gabapcia/gameblitz/internal/leaderboard"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 type UpsertPlayerRankReq struct {
-	Value float64 `json:"value"` // Value that will be used to update the player's rank
+	Value  float64           `json:"value"`                                  // Value that will be used to update the player's rank
+	Scopes map[string]string `json:"scopes" example:"region:eu,platform:pc"` // Scope partitions, by dimension, that will also rank the player
+}
+
+type UpsertPlayerRanksEntryReq struct {
+	PlayerID string            `json:"playerId"`                               // Player's ID
+	Value    float64           `json:"value"`                                  // Value that will be used to update the player's rank
+	Scopes   map[string]string `json:"scopes" example:"region:eu,platform:pc"` // Scope partitions, by dimension, that will also rank the player
+}
+
+type UpsertPlayerLeaderboardsRanksReq struct {
+	Values map[string]float64 `json:"values"` // Values that will be used to update the player's rank, by leaderboard ID
+}
+
+type RankEntryFailure struct {
+	Index    int           `json:"index"`    // Entry position on the request
+	PlayerID string        `json:"playerId"` // Player's ID
+	Error    ErrorResponse `json:"error"`    // Reason why the entry was not applied
+}
+
+type UpsertPlayerRanksResult struct {
+	Applied  int                `json:"applied"`  // Number of entries applied
+	Failures []RankEntryFailure `json:"failures"` // Entries that were not applied
+}
+
+func (r UpsertPlayerRankReq) scopesToDomain() []leaderboard.Scope {
+	return scopesToDomain(r.Scopes)
+}
+
+func (r UpsertPlayerRanksEntryReq) toDomain() leaderboard.RankEntry {
+	return leaderboard.RankEntry{
+		PlayerID: r.PlayerID,
+		Value:    r.Value,
+		Scopes:   scopesToDomain(r.Scopes),
+	}
+}
+
+func scopesToDomain(data map[string]string) []leaderboard.Scope {
+	scopes := make([]leaderboard.Scope, 0, len(data))
+	for dimension, value := range data {
+		scopes = append(scopes, leaderboard.Scope{Dimension: dimension, Value: value})
+	}
+
+	// Keeps the partitions in the same order no matter how the request was encoded
+	slices.SortFunc(scopes, func(a, b leaderboard.Scope) int {
+		return strings.Compare(a.Dimension, b.Dimension)
+	})
+
+	return scopes
 }
 
 type Rank struct {
-	PlayerID string  `json:"playerId"` // Player's ID
-	Position int64   `json:"position"` // Player ranking position
-	Value    float64 `json:"value"`    // Player rank value
+	PlayerID   string  `json:"playerId"`   // Player's ID
+	Position   int64   `json:"position"`   // Player ranking position
+	Value      float64 `json:"value"`      // Player rank value
+	Percentile float64 `json:"percentile"` // Top percentage of the ranked players that the player is in
+	Tier       string  `json:"tier"`       // Name of the tier that the player is placed on. Empty when no tier places the player
+}
+
+type Ranking struct {
+	Period string `json:"period"` // Ranking period ID. Empty for non recurring leaderboards
+	Total  int64  `json:"total"`  // Number of players ranked on the leaderboard
+	Ranks  []Rank `json:"ranks"`  // Ranking page
+}
+
+type RelativeRank struct {
+	PlayerID         string  `json:"playerId"`         // Player's ID
+	Position         int64   `json:"position"`         // Player ranking position
+	RelativePosition int64   `json:"relativePosition"` // Player position among the players requested
+	Value            float64 `json:"value"`            // Player rank value
+	Percentile       float64 `json:"percentile"`       // Top percentage of the ranked players that the player is in
+	Tier             string  `json:"tier"`             // Name of the tier that the player is placed on. Empty when no tier places the player
+}
+
+type PlayersRanking struct {
+	Period string         `json:"period"` // Ranking period ID. Empty for non recurring leaderboards
+	Total  int64          `json:"total"`  // Number of players ranked on the leaderboard
+	Ranks  []RelativeRank `json:"ranks"`  // Ranks of the players requested that are ranked
+}
+
+type PlayerRank struct {
+	Period     string  `json:"period"`     // Ranking period ID. Empty for non recurring leaderboards
+	Total      int64   `json:"total"`      // Number of players ranked on the leaderboard
+	PlayerID   string  `json:"playerId"`   // Player's ID
+	Position   int64   `json:"position"`   // Player ranking position
+	Value      float64 `json:"value"`      // Player rank value
+	Percentile float64 `json:"percentile"` // Top percentage of the ranked players that the player is in
+	Tier       string  `json:"tier"`       // Name of the tier that the player is placed on. Empty when no tier places the player
+	Above      []Rank  `json:"above"`      // Players ranked right above the player, closest last
+	Below      []Rank  `json:"below"`      // Players ranked right below the player, closest first
+}
+
+type LeaderboardRank struct {
+	LeaderboardID   string  `json:"leaderboardId"`   // Leaderboard's ID
+	LeaderboardName string  `json:"leaderboardName"` // Leaderboard's name
+	Period          string  `json:"period"`          // Ranking period ID. Empty for non recurring leaderboards
+	Total           int64   `json:"total"`           // Number of players ranked on the leaderboard
+	Position        int64   `json:"position"`        // Player ranking position
+	Value           float64 `json:"value"`           // Player rank value
+	Percentile      float64 `json:"percentile"`      // Top percentage of the ranked players that the player is in
+	Tier            string  `json:"tier"`            // Name of the tier that the player is placed on. Empty when no tier places the player
+}
+
+type PlayerLeaderboardsRanks struct {
+	PlayerID string            `json:"playerId"` // Player's ID
+	Ranks    []LeaderboardRank `json:"ranks"`    // Player's ranks on the leaderboards that rank it
 }
 
 func rankFromDomain(r leaderboard.Rank) Rank {
 	return Rank{
-		PlayerID: r.PlayerID,
-		Position: r.Position,
-		Value:    r.Value,
+		PlayerID:   r.PlayerID,
+		Position:   r.Position,
+		Value:      r.Value,
+		Percentile: r.Percentile,
+		Tier:       r.Tier,
+	}
+}
+
+func rankingFromDomain(r leaderboard.Ranking) Ranking {
+	ranks := make([]Rank, len(r.Ranks))
+	for i, rank := range r.Ranks {
+		ranks[i] = rankFromDomain(rank)
+	}
+
+	return Ranking{
+		Period: r.Period,
+		Total:  r.Total,
+		Ranks:  ranks,
+	}
+}
+
+func playersRankingFromDomain(r leaderboard.PlayersRanking) PlayersRanking {
+	ranks := make([]RelativeRank, len(r.Ranks))
+	for i, rank := range r.Ranks {
+		ranks[i] = RelativeRank{
+			PlayerID:         rank.PlayerID,
+			Position:         rank.Position,
+			RelativePosition: rank.RelativePosition,
+			Value:            rank.Value,
+			Percentile:       rank.Percentile,
+			Tier:             rank.Tier,
+		}
+	}
+
+	return PlayersRanking{
+		Period: r.Period,
+		Total:  r.Total,
+		Ranks:  ranks,
+	}
+}
+
+func playerRankFromDomain(r leaderboard.PlayerRank) PlayerRank {
+	above := make([]Rank, len(r.Above))
+	for i, rank := range r.Above {
+		above[i] = rankFromDomain(rank)
+	}
+
+	below := make([]Rank, len(r.Below))
+	for i, rank := range r.Below {
+		below[i] = rankFromDomain(rank)
+	}
+
+	return PlayerRank{
+		Period:     r.Period,
+		Total:      r.Total,
+		PlayerID:   r.PlayerID,
+		Position:   r.Position,
+		Value:      r.Value,
+		Percentile: r.Percentile,
+		Tier:       r.Tier,
+		Above:      above,
+		Below:      below,
+	}
+}
+
+func playerLeaderboardsRanksFromDomain(playerID string, ranks []leaderboard.LeaderboardRank) PlayerLeaderboardsRanks {
+	leaderboardRanks := make([]LeaderboardRank, len(ranks))
+	for i, rank := range ranks {
+		leaderboardRanks[i] = LeaderboardRank{
+			LeaderboardID:   rank.Leaderboard.ID,
+			LeaderboardName: rank.Leaderboard.Name,
+			Period:          rank.Period,
+			Total:           rank.Total,
+			Position:        rank.Position,
+			Value:           rank.Value,
+			Percentile:      rank.Percentile,
+			Tier:            rank.Tier,
+		}
+	}
+
+	return PlayerLeaderboardsRanks{
+		PlayerID: playerID,
+		Ranks:    leaderboardRanks,
 	}
 }
 
 var (
-	ErrorResponseLeaderboardClosed  = ErrorResponse{Code: "2.0", Message: "leaderboard closed"}
-	ErrorResponseRankingPageNumber  = ErrorResponse{Code: "2.1", Message: "invalid page number"}
-	ErrorResponseRankingLimitNumber = ErrorResponse{Code: "2.2", Message: "invalid limit number"}
+	ErrorResponseLeaderboardClosed   = ErrorResponse{Code: "2.0", Message: "leaderboard closed"}
+	ErrorResponseRankingPageNumber   = ErrorResponse{Code: "2.1", Message: "invalid page number"}
+	ErrorResponseRankingLimitNumber  = ErrorResponse{Code: "2.2", Message: "invalid limit number"}
+	ErrorResponseRankingAround       = ErrorResponse{Code: "2.3", Message: "invalid around number"}
+	ErrorResponsePlayerRankNotFound  = ErrorResponse{Code: "2.4", Message: "player rank not found"}
+	ErrorResponseRankingPeriod       = ErrorResponse{Code: "2.5", Message: "invalid period"}
+	ErrorResponseRankingScope        = ErrorResponse{Code: "2.8", Message: "invalid scope"}
+	ErrorResponseRankingPlayers      = ErrorResponse{Code: "2.9", Message: "invalid players number"}
+	ErrorResponseRankingEntries      = ErrorResponse{Code: "2.10", Message: "invalid entries number"}
+	ErrorResponseRankingPlayerID     = ErrorResponse{Code: "2.11", Message: "invalid player id"}
+	ErrorResponseRankingLeaderboards = ErrorResponse{Code: "2.19", Message: "invalid leaderboards number"}
 )
 
+// Identifies who is submitting rank values so it can be recorded on the players history
+func callerFromCtx(c *fiber.Ctx) leaderboard.Caller {
+	claims := c.Locals("claims").(auth.Claims)
+
+	return leaderboard.Caller{
+		GameID: claims.GameID,
+		Client: c.IP(),
+	}
+}
+
+// Maps the error that failed a single entry of a batch upsert
+func rankEntryErrorResponse(err error) ErrorResponse {
+	switch {
+	case errors.Is(err, leaderboard.ErrInvalidPlayerID):
+		return ErrorResponseRankingPlayerID
+	case errors.Is(err, leaderboard.ErrInvalidScope):
+		return ErrorResponseRankingScope
+	case errors.Is(err, leaderboard.ErrPlayerBanned):
+		return ErrorResponsePlayerBanned
+	case errors.Is(err, leaderboard.ErrValueOutOfBounds):
+		return ErrorResponseValueOutOfBounds
+	case errors.Is(err, leaderboard.ErrIncreaseTooHigh):
+		return ErrorResponseIncreaseTooHigh
+	case errors.Is(err, leaderboard.ErrTooManySubmissions):
+		return ErrorResponseTooManySubmissions
+	default:
+		zap.Error(err, "unknown rank entry error")
+		return ErrorResponseInternalServerError
+	}
+}
+
 // @summary Upsert Player Rank
 // @description Set or update a player's rank on the leaderboard
 // @router /api/v1/leaderboards/{leaderboardId}/ranking/{playerId} [POST]
@@ -42,7 +265,7 @@ var (
 // @param playerId path string true "Player ID"
 // @param UpsertPlayerRankData body UpsertPlayerRankReq true "Values to update the player rank"
 // @success 204
-// @failure 400,404,422,500 {object} ErrorResponse
+// @failure 400,404,422,429,500 {object} ErrorResponse
 func buildUpsertPlayerRankHandler(upsertPlayerRankFunc leaderboard.UpsertPlayerRankFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var (
@@ -55,7 +278,101 @@ func buildUpsertPlayerRankHandler(upsertPlayerRankFunc leaderboard.UpsertPlayerR
 			return err
 		}
 
-		if err := upsertPlayerRankFunc(c.Context(), leaderboard, playerID, body.Value); err != nil {
+		if err := upsertPlayerRankFunc(c.Context(), leaderboard, playerID, body.Value, body.scopesToDomain(), callerFromCtx(c)); err != nil {
+			return err
+		}
+
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
+
+// @summary Remove Player Rank
+// @description Remove the player's entry from the current ranking, including its scope partitions
+// @router /api/v1/leaderboards/{leaderboardId}/ranking/{playerId} [DELETE]
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @success 204
+// @failure 404,500 {object} ErrorResponse
+func buildRemovePlayerRankHandler(removePlayerRankFunc leaderboard.RemovePlayerRankFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+		)
+
+		if err := removePlayerRankFunc(c.Context(), leaderboard, playerID); err != nil {
+			return err
+		}
+
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
+
+// @summary Upsert Player Ranks
+// @description Set or update the rank of many players at once, like the results of a match. Entries are applied independently and the ones that fail are reported
+// @router /api/v1/leaderboards/{leaderboardId}/ranking [POST]
+// @accept json
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param UpsertPlayerRanksData body []UpsertPlayerRanksEntryReq true "Values to update the players rank (up to 500)"
+// @success 200 {object} UpsertPlayerRanksResult
+// @failure 400,404,422,500 {object} ErrorResponse
+func buildUpsertPlayerRanksHandler(upsertPlayerRanksFunc leaderboard.UpsertPlayerRanksFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var body []UpsertPlayerRanksEntryReq
+		if err := c.BodyParser(&body); err != nil {
+			return err
+		}
+
+		entries := make([]leaderboard.RankEntry, len(body))
+		for i, entry := range body {
+			entries[i] = entry.toDomain()
+		}
+
+		leaderboard := c.Locals("leaderboard").(leaderboard.Leaderboard)
+		errs, err := upsertPlayerRanksFunc(c.Context(), leaderboard, entries, callerFromCtx(c))
+		if err != nil {
+			return err
+		}
+
+		result := UpsertPlayerRanksResult{Failures: make([]RankEntryFailure, 0)}
+		for i, err := range errs {
+			if err == nil {
+				result.Applied++
+				continue
+			}
+
+			result.Failures = append(result.Failures, RankEntryFailure{
+				Index:    i,
+				PlayerID: body[i].PlayerID,
+				Error:    rankEntryErrorResponse(err),
+			})
+		}
+
+		return c.Status(http.StatusOK).JSON(result)
+	}
+}
+
+// @summary Upsert Player Leaderboards Ranks
+// @description Set or update the player's rank on many leaderboards at once, like the ones updated by a single match. Either every rank is updated or none is
+// @router /api/v1/players/{playerId}/rankings [POST]
+// @accept json
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param playerId path string true "Player ID"
+// @param UpsertPlayerLeaderboardsRanksData body UpsertPlayerLeaderboardsRanksReq true "Values to update the player rank, by leaderboard ID (up to 20)"
+// @success 204
+// @failure 400,404,422,429,500 {object} ErrorResponse
+func buildUpsertPlayerLeaderboardsRanksHandler(upsertPlayerLeaderboardsRanksFunc leaderboard.UpsertPlayerLeaderboardsRanksFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var body UpsertPlayerLeaderboardsRanksReq
+		if err := c.BodyParser(&body); err != nil {
+			return err
+		}
+
+		if err := upsertPlayerLeaderboardsRanksFunc(c.Context(), c.Params("playerId"), body.Values, callerFromCtx(c)); err != nil {
 			return err
 		}
 
@@ -63,34 +380,135 @@ func buildUpsertPlayerRankHandler(upsertPlayerRankFunc leaderboard.UpsertPlayerR
 	}
 }
 
+// @summary Player Leaderboards Ranks
+// @description Get the player's rank on every leaderboard of the game. Deleted leaderboards and the ones that don't rank the player are skipped
+// @router /api/v1/players/{playerId}/rankings [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param playerId path string true "Player ID"
+// @success 200 {object} PlayerLeaderboardsRanks
+// @failure 422,500 {object} ErrorResponse
+func buildGetPlayerLeaderboardsRanksHandler(playerLeaderboardsRanksFunc leaderboard.PlayerLeaderboardsRanksFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			claims   = c.Locals("claims").(auth.Claims)
+			playerID = c.Params("playerId")
+		)
+
+		ranks, err := playerLeaderboardsRanksFunc(c.Context(), claims.GameID, playerID)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(playerLeaderboardsRanksFromDomain(playerID, ranks))
+	}
+}
+
 // @summary Leaderboard Ranking
 // @description Get the leaderboard ranking paginated
 // @router /api/v1/leaderboards/{leaderboardId}/ranking [GET]
 // @produce json
 // @param Authorization header string true "Game's JWT authorization"
 // @param leaderboardId path string true "Leaderboard ID"
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the current one"
+// @param scope query string false "Scope partition formatted as `{dimension}:{value}`, like `region:eu`. Defaults to the global ranking"
 // @param page query int false "Page number" minimun(0) default(0)
 // @param limit query int false "Number of rankings per page" minimun(1) maximum(500) default(10)
-// @success 200 {array} Rank
+// @success 200 {object} Ranking
 // @failure 400,404,422,500 {object} ErrorResponse
 func buildGetRankingHandler(rankingFunc leaderboard.RankingFunc) fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		scope, err := leaderboard.ParseScope(c.Query("scope"))
+		if err != nil {
+			return err
+		}
+
 		var (
 			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			period      = c.Query("period")
 			page        = c.QueryInt("page", 0)
 			limit       = c.QueryInt("limit", 10)
 		)
 
-		rankings, err := rankingFunc(c.Context(), leaderboard, int64(page), int64(limit))
+		ranking, err := rankingFunc(c.Context(), leaderboard, period, scope, int64(page), int64(limit))
 		if err != nil {
 			return err
 		}
 
-		data := make([]Rank, len(rankings))
-		for i, rank := range rankings {
-			data[i] = rankFromDomain(rank)
+		return c.Status(http.StatusOK).JSON(rankingFromDomain(ranking))
+	}
+}
+
+// @summary Player Rank
+// @description Get the player's rank and the players ranked right above and below it
+// @router /api/v1/leaderboards/{leaderboardId}/ranking/{playerId} [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the current one"
+// @param scope query string false "Scope partition formatted as `{dimension}:{value}`, like `region:eu`. Defaults to the global ranking"
+// @param around query int false "Number of players to return above and below the player" minimun(0) maximum(50) default(5)
+// @success 200 {object} PlayerRank
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetPlayerRankHandler(playerRankFunc leaderboard.PlayerRankFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		scope, err := leaderboard.ParseScope(c.Query("scope"))
+		if err != nil {
+			return err
+		}
+
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+			period      = c.Query("period")
+			around      = c.QueryInt("around", 5)
+		)
+
+		playerRank, err := playerRankFunc(c.Context(), leaderboard, period, scope, playerID, int64(around))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(playerRankFromDomain(playerRank))
+	}
+}
+
+// @summary Players Ranking
+// @description Get the ranking of a set of players, like a player's friends, with their global and relative positions. Players that are not ranked are skipped
+// @router /api/v1/leaderboards/{leaderboardId}/ranking/players [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerIds query string true "Comma separated player IDs (up to 100)"
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the current one"
+// @param scope query string false "Scope partition formatted as `{dimension}:{value}`, like `region:eu`. Defaults to the global ranking"
+// @success 200 {object} PlayersRanking
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetPlayersRankingHandler(playersRankingFunc leaderboard.PlayersRankingFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		scope, err := leaderboard.ParseScope(c.Query("scope"))
+		if err != nil {
+			return err
+		}
+
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			period      = c.Query("period")
+			playerIDs   = make([]string, 0)
+		)
+
+		for _, playerID := range strings.Split(c.Query("playerIds"), ",") {
+			if playerID = strings.TrimSpace(playerID); playerID != "" {
+				playerIDs = append(playerIDs, playerID)
+			}
+		}
+
+		ranking, err := playersRankingFunc(c.Context(), leaderboard, period, scope, playerIDs)
+		if err != nil {
+			return err
 		}
 
-		return c.Status(http.StatusOK).JSON(data)
+		return c.Status(http.StatusOK).JSON(playersRankingFromDomain(ranking))
 	}
 }
@@ -34,12 +34,15 @@ func TestBuildUpsertPlayerRankHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			UpsertPlayerRankFunc: func(ctx context.Context, leaderboard leaderboard.Leaderboard, playerID string, value float64) error {
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
+				assert.Equal(t, []leaderboard.Scope{{Dimension: "platform", Value: "pc"}, {Dimension: "region", Value: "eu"}}, scopes)
+				assert.Equal(t, gameID, caller.GameID)
+				assert.NotEmpty(t, caller.Client)
 				return nil
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), bytes.NewBufferString(`{"value": 100.0}`))
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), bytes.NewBufferString(`{"value": 100.0, "scopes": {"region": "eu", "platform": "pc"}}`))
 
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", uuid.NewString())
@@ -111,7 +114,7 @@ func TestBuildUpsertPlayerRankHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64) error {
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
 				return leaderboard.ErrLeaderboardClosed
 			},
 		})
@@ -133,6 +136,94 @@ func TestBuildUpsertPlayerRankHandler(t *testing.T) {
 		assert.Equal(t, ErrorResponseLeaderboardClosed.Message, body.Message)
 	})
 
+	t.Run("Player Banned", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
+				return leaderboard.ErrPlayerBanned
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), bytes.NewBufferString(`{"value": 100.0}`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponsePlayerBanned.Code, body.Code)
+		assert.Equal(t, ErrorResponsePlayerBanned.Message, body.Message)
+	})
+	t.Run("Value Out Of Bounds", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
+				return leaderboard.ErrValueOutOfBounds
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), bytes.NewBufferString(`{"value": 100.0}`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseValueOutOfBounds.Code, body.Code)
+		assert.Equal(t, ErrorResponseValueOutOfBounds.Message, body.Message)
+	})
+	t.Run("Too Many Submissions", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
+				return leaderboard.ErrTooManySubmissions
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), bytes.NewBufferString(`{"value": 100.0}`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseTooManySubmissions.Code, body.Code)
+		assert.Equal(t, ErrorResponseTooManySubmissions.Message, body.Message)
+	})
+
 	t.Run("Random Error", func(t *testing.T) {
 		zap.Start()
 		defer zap.Sync()
@@ -144,7 +235,7 @@ func TestBuildUpsertPlayerRankHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			UpsertPlayerRankFunc: func(ctx context.Context, leaderboard leaderboard.Leaderboard, playerID string, value float64) error {
+			UpsertPlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) error {
 				return errors.New("any error")
 			},
 		})
@@ -167,10 +258,11 @@ func TestBuildUpsertPlayerRankHandler(t *testing.T) {
 	})
 }
 
-func TestBuildGetRankingHandler(t *testing.T) {
+func TestBuildRemovePlayerRankHandler(t *testing.T) {
 	var (
 		leaderboardID = uuid.NewString()
 		gameID        = uuid.NewString()
+		playerID      = uuid.NewString()
 	)
 
 	t.Run("OK", func(t *testing.T) {
@@ -181,61 +273,92 @@ func TestBuildGetRankingHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, page, limit int64) ([]leaderboard.Rank, error) {
-				rankings := make([]leaderboard.Rank, 0)
-				for i := 0; i < 10; i++ {
-					rankings = append(rankings, leaderboard.Rank{
-						LeaderboardID: uuid.NewString(),
-						PlayerID:      uuid.NewString(),
-						Position:      int64(i),
-						Value:         rand.Float64(),
-					})
-				}
+			RemovePlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string) error {
+				return nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
 
-				return rankings, nil
+	t.Run("Player Rank Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RemovePlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string) error {
+				return leaderboard.ErrPlayerRankNotFound
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
 
 		req.Header.Set("Authorization", uuid.NewString())
 
 		resp, err := app.Test(req)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 
-		var data []Rank
-		err = json.NewDecoder(resp.Body).Decode(&data)
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
 		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponsePlayerRankNotFound.Code, body.Code)
+		assert.Equal(t, ErrorResponsePlayerRankNotFound.Message, body.Message)
 	})
 
-	t.Run("Leaderboard Not Found", func(t *testing.T) {
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
 		app := App(Config{
 			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
 				return auth.Claims{GameID: gameID}, nil
 			},
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
-				return leaderboard.Leaderboard{}, leaderboard.ErrLeaderboardNotFound
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RemovePlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, playerID string) error {
+				return errors.New("any error")
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
 
 		req.Header.Set("Authorization", uuid.NewString())
 
 		resp, err := app.Test(req)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 
 		var body ErrorResponse
 		err = json.NewDecoder(resp.Body).Decode(&body)
 		assert.NoError(t, err)
 
-		assert.Equal(t, ErrorResponseLeaderboardNotFound.Code, body.Code)
-		assert.Equal(t, ErrorResponseLeaderboardNotFound.Message, body.Message)
+		assert.Equal(t, ErrorResponseInternalServerError.Code, body.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
 	})
+}
+
+func TestBuildUpsertPlayerRanksHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
 
-	t.Run("Invalid Page Number", func(t *testing.T) {
 		app := App(Config{
 			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
 				return auth.Claims{GameID: gameID}, nil
@@ -243,32 +366,67 @@ func TestBuildGetRankingHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, page, limit int64) ([]leaderboard.Rank, error) {
-				return nil, leaderboard.ErrInvalidPageNumber
+			UpsertPlayerRanksFunc: func(ctx context.Context, lb leaderboard.Leaderboard, entries []leaderboard.RankEntry, caller leaderboard.Caller) ([]error, error) {
+				assert.Len(t, entries, 4)
+				assert.Equal(t, []leaderboard.Scope{{Dimension: "platform", Value: "pc"}, {Dimension: "region", Value: "eu"}}, entries[0].Scopes)
+				return []error{nil, leaderboard.ErrInvalidPlayerID, leaderboard.ErrInvalidScope, errors.New("any error")}, nil
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), bytes.NewBufferString(`[
+			{"playerId": "a", "value": 10, "scopes": {"region": "eu", "platform": "pc"}},
+			{"playerId": "", "value": 5},
+			{"playerId": "c", "value": 5, "scopes": {"mode": "solo"}},
+			{"playerId": "d", "value": 1}
+		]`))
 
+		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", uuid.NewString())
 
-		q := req.URL.Query()
-		q.Set("page", "-1")
-		req.URL.RawQuery = q.Encode()
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body UpsertPlayerRanksResult
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, body.Applied)
+		assert.Equal(t, []RankEntryFailure{
+			{Index: 1, PlayerID: "", Error: ErrorResponseRankingPlayerID},
+			{Index: 2, PlayerID: "c", Error: ErrorResponseRankingScope},
+			{Index: 3, PlayerID: "d", Error: ErrorResponseInternalServerError},
+		}, body.Failures)
+	})
+
+	t.Run("Invalid Request Body", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), bytes.NewBufferString(`[`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
 
 		resp, err := app.Test(req)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 
 		var body ErrorResponse
 		err = json.NewDecoder(resp.Body).Decode(&body)
 		assert.NoError(t, err)
 
-		assert.Equal(t, ErrorResponseRankingPageNumber.Code, body.Code)
-		assert.Equal(t, ErrorResponseRankingPageNumber.Message, body.Message)
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Code, body.Code)
+		assert.Equal(t, ErrorResponseInvalidRequestBody.Message, body.Message)
 	})
 
-	t.Run("Invalid Limit Number", func(t *testing.T) {
+	t.Run("Invalid Entries Number", func(t *testing.T) {
 		app := App(Config{
 			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
 				return auth.Claims{GameID: gameID}, nil
@@ -276,19 +434,16 @@ func TestBuildGetRankingHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, page, limit int64) ([]leaderboard.Rank, error) {
-				return nil, leaderboard.ErrInvalidLimitNumber
+			UpsertPlayerRanksFunc: func(ctx context.Context, lb leaderboard.Leaderboard, entries []leaderboard.RankEntry, caller leaderboard.Caller) ([]error, error) {
+				return nil, leaderboard.ErrInvalidEntriesNumber
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), bytes.NewBufferString(`[]`))
 
+		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", uuid.NewString())
 
-		q := req.URL.Query()
-		q.Set("limit", "-1")
-		req.URL.RawQuery = q.Encode()
-
 		resp, err := app.Test(req)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
@@ -297,8 +452,8 @@ func TestBuildGetRankingHandler(t *testing.T) {
 		err = json.NewDecoder(resp.Body).Decode(&body)
 		assert.NoError(t, err)
 
-		assert.Equal(t, ErrorResponseRankingLimitNumber.Code, body.Code)
-		assert.Equal(t, ErrorResponseRankingLimitNumber.Message, body.Message)
+		assert.Equal(t, ErrorResponseRankingEntries.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingEntries.Message, body.Message)
 	})
 
 	t.Run("Random Error", func(t *testing.T) {
@@ -312,13 +467,14 @@ func TestBuildGetRankingHandler(t *testing.T) {
 			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
 				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
 			},
-			RankingFunc: func(ctx context.Context, leaderboard leaderboard.Leaderboard, page, limit int64) ([]leaderboard.Rank, error) {
+			UpsertPlayerRanksFunc: func(ctx context.Context, lb leaderboard.Leaderboard, entries []leaderboard.RankEntry, caller leaderboard.Caller) ([]error, error) {
 				return nil, errors.New("any error")
 			},
 		})
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), bytes.NewBufferString(`[{"playerId": "a", "value": 1}]`))
 
+		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", uuid.NewString())
 
 		resp, err := app.Test(req)
@@ -333,3 +489,660 @@ func TestBuildGetRankingHandler(t *testing.T) {
 		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
 	})
 }
+
+func TestBuildUpsertPlayerLeaderboardsRanksHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			UpsertPlayerLeaderboardsRanksFunc: func(ctx context.Context, id string, values map[string]float64, caller leaderboard.Caller) error {
+				assert.Equal(t, playerID, id)
+				assert.Equal(t, map[string]float64{leaderboardID: 100}, values)
+				assert.Equal(t, gameID, caller.GameID)
+				return nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), bytes.NewBufferString(fmt.Sprintf(`{"values": {"%s": 100.0}}`, leaderboardID)))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("Invalid Request Body", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), bytes.NewBufferString(`{`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var data ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInvalidRequestBody, data)
+	})
+
+	t.Run("Invalid Leaderboards Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			UpsertPlayerLeaderboardsRanksFunc: func(ctx context.Context, playerID string, values map[string]float64, caller leaderboard.Caller) error {
+				return leaderboard.ErrInvalidLeaderboardsNumber
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), bytes.NewBufferString(`{"values": {}}`))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingLeaderboards, body)
+	})
+
+	t.Run("Leaderboard Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			UpsertPlayerLeaderboardsRanksFunc: func(ctx context.Context, playerID string, values map[string]float64, caller leaderboard.Caller) error {
+				return fmt.Errorf("Leaderboard %s: %w", leaderboardID, leaderboard.ErrLeaderboardNotFound)
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), bytes.NewBufferString(fmt.Sprintf(`{"values": {"%s": 100.0}}`, leaderboardID)))
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardNotFound, body)
+	})
+}
+
+func TestBuildGetPlayerLeaderboardsRanksHandler(t *testing.T) {
+	var (
+		gameID   = uuid.NewString()
+		playerID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		lb := leaderboard.Leaderboard{ID: uuid.NewString(), GameID: gameID, Name: "Weekly"}
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			PlayerLeaderboardsRanksFunc: func(ctx context.Context, id, player string) ([]leaderboard.LeaderboardRank, error) {
+				assert.Equal(t, gameID, id)
+				assert.Equal(t, playerID, player)
+				return []leaderboard.LeaderboardRank{{
+					Leaderboard: lb,
+					Period:      "2024-W01",
+					Total:       50,
+					Rank:        leaderboard.Rank{LeaderboardID: lb.ID, PlayerID: player, Position: 4, Value: 100, Percentile: 10, Tier: "Gold"},
+				}}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body PlayerLeaderboardsRanks
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, PlayerLeaderboardsRanks{
+			PlayerID: playerID,
+			Ranks: []LeaderboardRank{{
+				LeaderboardID:   lb.ID,
+				LeaderboardName: lb.Name,
+				Period:          "2024-W01",
+				Total:           50,
+				Position:        4,
+				Value:           100,
+				Percentile:      10,
+				Tier:            "Gold",
+			}},
+		}, body)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			PlayerLeaderboardsRanksFunc: func(ctx context.Context, gameID, playerID string) ([]leaderboard.LeaderboardRank, error) {
+				return nil, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/players/%s/rankings", playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
+func TestBuildGetRankingHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+				rankings := make([]leaderboard.Rank, 0)
+				for i := 0; i < 10; i++ {
+					rankings = append(rankings, leaderboard.Rank{
+						LeaderboardID: uuid.NewString(),
+						PlayerID:      uuid.NewString(),
+						Position:      page*limit + int64(i),
+						Value:         rand.Float64(),
+					})
+				}
+
+				return leaderboard.Ranking{Total: 100, Ranks: rankings}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data Ranking
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(100), data.Total)
+		assert.Len(t, data.Ranks, 10)
+	})
+
+	t.Run("Leaderboard Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{}, leaderboard.ErrLeaderboardNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Code, body.Code)
+		assert.Equal(t, ErrorResponseLeaderboardNotFound.Message, body.Message)
+	})
+
+	t.Run("Invalid Page Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+				return leaderboard.Ranking{}, leaderboard.ErrInvalidPageNumber
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		q := req.URL.Query()
+		q.Set("page", "-1")
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingPageNumber.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingPageNumber.Message, body.Message)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID, Recurrence: leaderboard.RecurrenceDaily}, nil
+			},
+			RankingFunc: leaderboard.BuildRankingFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking?period=2024-W01", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingPeriod.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingPeriod.Message, body.Message)
+	})
+
+	t.Run("Scoped", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID, Scopes: []string{"region"}}, nil
+			},
+			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+				assert.Equal(t, leaderboard.Scope{Dimension: "region", Value: "eu"}, scope)
+				return leaderboard.Ranking{Total: 0, Ranks: make([]leaderboard.Rank, 0)}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking?scope=region:eu", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Invalid Scope", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID, Scopes: []string{"region"}}, nil
+			},
+			RankingFunc: leaderboard.BuildRankingFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking?scope=platform:pc", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingScope.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingScope.Message, body.Message)
+	})
+
+	t.Run("Invalid Limit Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+				return leaderboard.Ranking{}, leaderboard.ErrInvalidLimitNumber
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		q := req.URL.Query()
+		q.Set("limit", "-1")
+		req.URL.RawQuery = q.Encode()
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingLimitNumber.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingLimitNumber.Message, body.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			RankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+				return leaderboard.Ranking{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, body.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
+	})
+}
+
+func TestBuildGetPlayerRankHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerID string, around int64) (leaderboard.PlayerRank, error) {
+				return leaderboard.PlayerRank{
+					Total: 10,
+					Rank:  leaderboard.Rank{LeaderboardID: lb.ID, PlayerID: playerID, Position: 1, Value: 90, Percentile: 20, Tier: "Gold"},
+					Above: []leaderboard.Rank{{LeaderboardID: lb.ID, PlayerID: uuid.NewString(), Position: 0, Value: 100, Percentile: 10, Tier: "Gold"}},
+					Below: []leaderboard.Rank{{LeaderboardID: lb.ID, PlayerID: uuid.NewString(), Position: 2, Value: 80, Percentile: 30}},
+				}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data PlayerRank
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, playerID, data.PlayerID)
+		assert.Equal(t, int64(1), data.Position)
+		assert.Equal(t, int64(10), data.Total)
+		assert.Equal(t, float64(20), data.Percentile)
+		assert.Equal(t, "Gold", data.Tier)
+		assert.Len(t, data.Above, 1)
+		assert.Len(t, data.Below, 1)
+		assert.Empty(t, data.Below[0].Tier)
+	})
+
+	t.Run("Invalid Around Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerID string, around int64) (leaderboard.PlayerRank, error) {
+				return leaderboard.PlayerRank{}, leaderboard.ErrInvalidAroundNumber
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s?around=-1", leaderboardID, playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingAround.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingAround.Message, body.Message)
+	})
+
+	t.Run("Player Rank Not Found", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerID string, around int64) (leaderboard.PlayerRank, error) {
+				return leaderboard.PlayerRank{}, leaderboard.ErrPlayerRankNotFound
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponsePlayerRankNotFound.Code, body.Code)
+		assert.Equal(t, ErrorResponsePlayerRankNotFound.Message, body.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayerRankFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerID string, around int64) (leaderboard.PlayerRank, error) {
+				return leaderboard.PlayerRank{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/%s", leaderboardID, playerID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseInternalServerError.Code, body.Code)
+		assert.Equal(t, ErrorResponseInternalServerError.Message, body.Message)
+	})
+}
+
+func TestBuildGetPlayersRankingHandler(t *testing.T) {
+	var (
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayersRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerIDs []string) (leaderboard.PlayersRanking, error) {
+				assert.Equal(t, []string{"a", "b"}, playerIDs)
+				return leaderboard.PlayersRanking{Ranks: []leaderboard.RelativeRank{
+					{Rank: leaderboard.Rank{PlayerID: "b", Position: 3, Value: 20}, RelativePosition: 0},
+					{Rank: leaderboard.Rank{PlayerID: "a", Position: 8, Value: 10}, RelativePosition: 1},
+				}}, nil
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/players?playerIds=a,b", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var data PlayersRanking
+		err = json.NewDecoder(resp.Body).Decode(&data)
+		assert.NoError(t, err)
+
+		assert.Len(t, data.Ranks, 2)
+		assert.Equal(t, "b", data.Ranks[0].PlayerID)
+		assert.Equal(t, int64(3), data.Ranks[0].Position)
+		assert.Equal(t, int64(1), data.Ranks[1].RelativePosition)
+	})
+
+	t.Run("Invalid Players Number", func(t *testing.T) {
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayersRankingFunc: leaderboard.BuildPlayersRankingFunc(nil),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/players", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		var body ErrorResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrorResponseRankingPlayers.Code, body.Code)
+		assert.Equal(t, ErrorResponseRankingPlayers.Message, body.Message)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		zap.Start()
+		defer zap.Sync()
+
+		app := App(Config{
+			AuthenticateFunc: func(ctx context.Context, credentials string) (auth.Claims, error) {
+				return auth.Claims{GameID: gameID}, nil
+			},
+			GetLeaderboardByIDAndGameIDFunc: func(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+				return leaderboard.Leaderboard{ID: id, GameID: gameID}, nil
+			},
+			PlayersRankingFunc: func(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerIDs []string) (leaderboard.PlayersRanking, error) {
+				return leaderboard.PlayersRanking{}, errors.New("any error")
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/leaderboards/%s/ranking/players?playerIds=a", leaderboardID), nil)
+
+		req.Header.Set("Authorization", uuid.NewString())
+
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
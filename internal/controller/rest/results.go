@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type Results struct {
+	Period      string    `json:"period"`      // Ranking period ID. Empty for non recurring leaderboards
+	FinalizedAt time.Time `json:"finalizedAt"` // Time that the ranking was frozen
+	Total       int64     `json:"total"`       // Number of players ranked on the leaderboard
+	Ranks       []Rank    `json:"ranks"`       // Final ranking page
+}
+
+func resultsFromDomain(r leaderboard.Results) Results {
+	ranks := make([]Rank, len(r.Ranks))
+	for i, rank := range r.Ranks {
+		ranks[i] = rankFromDomain(rank)
+	}
+
+	return Results{
+		Period:      r.Period,
+		FinalizedAt: r.FinalizedAt,
+		Total:       r.Total,
+		Ranks:       ranks,
+	}
+}
+
+type Reward struct {
+	Period   string  `json:"period"`   // Ranking period ID. Empty for non recurring leaderboards
+	PlayerID string  `json:"playerId"` // Player's ID
+	Position int64   `json:"position"` // Player's final ranking position
+	Value    float64 `json:"value"`    // Player's final rank value
+	Tier     string  `json:"tier"`     // Name of the tier earned by the player
+}
+
+func rewardFromDomain(r leaderboard.Reward) Reward {
+	return Reward{
+		Period:   r.Period,
+		PlayerID: r.PlayerID,
+		Position: r.Position,
+		Value:    r.Value,
+		Tier:     r.Tier,
+	}
+}
+
+var (
+	ErrorResponseResultsNotFound = ErrorResponse{Code: "2.6", Message: "results not found"}
+	ErrorResponseRewardNotFound  = ErrorResponse{Code: "2.7", Message: "reward not found"}
+)
+
+// @summary Leaderboard Results
+// @description Get the final ranking of a closed leaderboard period paginated
+// @router /api/v1/leaderboards/{leaderboardId}/results [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the last one closed"
+// @param page query int false "Page number" minimun(0) default(0)
+// @param limit query int false "Number of rankings per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} Results
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetResultsHandler(resultsFunc leaderboard.ResultsFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			period      = c.Query("period")
+			page        = c.QueryInt("page", 0)
+			limit       = c.QueryInt("limit", 10)
+		)
+
+		results, err := resultsFunc(c.Context(), leaderboard, period, int64(page), int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(resultsFromDomain(results))
+	}
+}
+
+// @summary Player Reward
+// @description Get the reward tier earned by the player when a leaderboard period closed
+// @router /api/v1/leaderboards/{leaderboardId}/rewards/{playerId} [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param playerId path string true "Player ID"
+// @param period query string false "Period ID (`2006-01-02`, `2006-W01` or `2006-01` for daily, weekly and monthly leaderboards). Defaults to the last one closed"
+// @success 200 {object} Reward
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetPlayerRewardHandler(playerRewardFunc leaderboard.PlayerRewardFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			playerID    = c.Params("playerId")
+			period      = c.Query("period")
+		)
+
+		reward, err := playerRewardFunc(c.Context(), leaderboard, period, playerID)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(rewardFromDomain(reward))
+	}
+}
@@ -29,25 +29,49 @@ type Config struct {
 
 	// Leaderboard
 	CreateLeaderboardFunc              leaderboard.CreateFunc
+	ListLeaderboardsFunc               leaderboard.ListFunc
+	UpdateLeaderboardFunc              leaderboard.UpdateFunc
 	GetLeaderboardByIDAndGameIDFunc    leaderboard.GetByIDAndGameIDFunc
 	DeleteLeaderboardByIDAndGameIDFunc leaderboard.SoftDeleteFunc
-
-	UpsertPlayerRankFunc leaderboard.UpsertPlayerRankFunc
-	RankingFunc          leaderboard.RankingFunc
+	RestoreLeaderboardFunc             leaderboard.RestoreFunc
+
+	UpsertPlayerRankFunc              leaderboard.UpsertPlayerRankFunc
+	UpsertPlayerRanksFunc             leaderboard.UpsertPlayerRanksFunc
+	UpsertPlayerLeaderboardsRanksFunc leaderboard.UpsertPlayerLeaderboardsRanksFunc
+	PlayerLeaderboardsRanksFunc       leaderboard.PlayerLeaderboardsRanksFunc
+	RankingFunc                       leaderboard.RankingFunc
+	ExportRankingFunc                 leaderboard.ExportRankingFunc
+	PlayerRankFunc                    leaderboard.PlayerRankFunc
+	PlayersRankingFunc                leaderboard.PlayersRankingFunc
+	ResultsFunc                       leaderboard.ResultsFunc
+	ExportResultsFunc                 leaderboard.ExportResultsFunc
+	PlayerRewardFunc                  leaderboard.PlayerRewardFunc
+	PlayerHistoryFunc                 leaderboard.PlayerHistoryFunc
+	RejectionsFunc                    leaderboard.RejectionsFunc
+	RemovePlayerRankFunc              leaderboard.RemovePlayerRankFunc
+	BanPlayerFunc                     leaderboard.BanPlayerFunc
+	BansFunc                          leaderboard.BansFunc
+	UnbanPlayerFunc                   leaderboard.UnbanPlayerFunc
 
 	// Quest
 	CreateQuestFunc           quest.CreateQuestFunc
+	ListQuestsFunc            quest.ListQuestsFunc
 	GetQuestByIDAndGameIDFunc quest.GetQuestByIDAndGameIDFunc
+	UpdateQuestFunc           quest.UpdateQuestFunc
 	SoftDeleteQuestFunc       quest.SoftDeleteQuestFunc
+	RestoreQuestFunc          quest.RestoreQuestFunc
 
 	StartQuestForPlayerFunc          quest.StartQuestForPlayerFunc
+	ListPlayerQuestsFunc             quest.ListPlayerQuestsFunc
 	GetPlayerQuestProgressionFunc    quest.GetPlayerQuestProgressionFunc
 	UpdatePlayerQuestProgressionFunc quest.UpdatePlayerQuestProgressionFunc
+	MigratePlayerQuestFunc           quest.MigratePlayerQuestFunc
 
 	// Statistic
 	CreateStatisticFunc                  statistic.CreateFunc
 	GetStatisticByIDAndGameIDFunc        statistic.GetByIDAndGameIDFunc
 	SoftDeleteStatisticByIDAndGameIDFunc statistic.SoftDeleteByIDAndGameIDFunc
+	RestoreStatisticByIDAndGameIDFunc    statistic.RestoreByIDAndGameIDFunc
 
 	UpsertPlayerStatisticProgressionFunc statistic.UpsertPlayerProgressionFunc
 	GetPlayerStatisticProgressionFunc    statistic.GetPlayerProgressionFunc
@@ -72,34 +96,71 @@ func App(config Config) *fiber.App {
 		Expiration:   config.CacheExpiration,
 		Storage:      config.CacheSorage,
 		CacheControl: true,
+		Next:         isExportRequest,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			// Cached pages depend on the query string and must never leak between games
+			claims := c.Locals("claims").(auth.Claims)
+			return claims.GameID + ":" + c.OriginalURL()
+		},
 	}))
 
 	// Leaderboards
 	leaderboards := api.Group("/leaderboards")
 	leaderboards.Post("/", buildCreateLeaderboardHandler(config.CreateLeaderboardFunc))
+	leaderboards.Get("/", buildListLeaderboardsHandler(config.ListLeaderboardsFunc))
 	leaderboards.Get("/:leaderboardId", buildGetLeaderboardHandler(config.GetLeaderboardByIDAndGameIDFunc))
-	leaderboards.Delete("/:leaderboardId", buildDeleteLeaderboardHandler(config.DeleteLeaderboardByIDAndGameIDFunc))
+	leaderboards.Patch("/:leaderboardId", buildUpdateLeaderboardHandler(config.CacheSorage, config.UpdateLeaderboardFunc))
+	leaderboards.Delete("/:leaderboardId", buildDeleteLeaderboardHandler(config.CacheSorage, config.DeleteLeaderboardByIDAndGameIDFunc))
+	leaderboards.Post("/:leaderboardId/restore", buildRestoreLeaderboardHandler(config.CacheSorage, config.RestoreLeaderboardFunc))
 
 	rankings := leaderboards.Group("/:leaderboardId/ranking", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc))
 	rankings.Get("/", buildGetRankingHandler(config.RankingFunc))
+	rankings.Post("/", buildUpsertPlayerRanksHandler(config.UpsertPlayerRanksFunc))
+	rankings.Get("/players", buildGetPlayersRankingHandler(config.PlayersRankingFunc)) // Must be registered before the player rank route
+	rankings.Get("/export", buildExportRankingHandler(config.ExportRankingFunc))       // Must be registered before the player rank route
+	rankings.Get("/:playerId", buildGetPlayerRankHandler(config.PlayerRankFunc))
 	rankings.Post("/:playerId", buildUpsertPlayerRankHandler(config.UpsertPlayerRankFunc))
+	rankings.Delete("/:playerId", buildRemovePlayerRankHandler(config.RemovePlayerRankFunc))
+	rankings.Get("/:playerId/history", buildGetPlayerHistoryHandler(config.PlayerHistoryFunc))
+
+	leaderboards.Get("/:leaderboardId/rejections", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc), buildGetRejectionsHandler(config.RejectionsFunc))
+
+	bans := leaderboards.Group("/:leaderboardId/bans", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc))
+	bans.Get("/", buildGetBansHandler(config.BansFunc))
+	bans.Post("/:playerId", buildBanPlayerHandler(config.BanPlayerFunc))
+	bans.Delete("/:playerId", buildUnbanPlayerHandler(config.UnbanPlayerFunc))
+
+	leaderboards.Get("/:leaderboardId/results", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc), buildGetResultsHandler(config.ResultsFunc))
+	leaderboards.Get("/:leaderboardId/results/export", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc), buildExportResultsHandler(config.ExportResultsFunc))
+	leaderboards.Get("/:leaderboardId/rewards/:playerId", buildGetLeaderboardMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetLeaderboardByIDAndGameIDFunc), buildGetPlayerRewardHandler(config.PlayerRewardFunc))
+
+	// Players
+	players := api.Group("/players")
+	players.Post("/:playerId/rankings", buildUpsertPlayerLeaderboardsRanksHandler(config.UpsertPlayerLeaderboardsRanksFunc))
+	players.Get("/:playerId/rankings", buildGetPlayerLeaderboardsRanksHandler(config.PlayerLeaderboardsRanksFunc))
+	players.Get("/:playerId/quests", buildListPlayerQuestsHandler(config.ListPlayerQuestsFunc))
 
 	// Quests
 	quests := api.Group("/quests")
 	quests.Post("/", buildCreateQuestHanlder(config.CreateQuestFunc))
+	quests.Get("/", buildListQuestsHandler(config.ListQuestsFunc))
 	quests.Get("/:questId", buildGetQuestHanlder(config.GetQuestByIDAndGameIDFunc))
-	quests.Delete("/:questId", buildDeleteQuestHanlder(config.SoftDeleteQuestFunc))
+	quests.Put("/:questId", buildUpdateQuestHandler(config.CacheSorage, config.UpdateQuestFunc))
+	quests.Delete("/:questId", buildDeleteQuestHanlder(config.CacheSorage, config.SoftDeleteQuestFunc))
+	quests.Post("/:questId/restore", buildRestoreQuestHanlder(config.CacheSorage, config.RestoreQuestFunc))
 
 	playerQuests := quests.Group("/:questId/players", buildGetQuestMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetQuestByIDAndGameIDFunc))
 	playerQuests.Post("/:playerId", buildStartPlayerQuestHandler(config.StartQuestForPlayerFunc))
 	playerQuests.Get("/:playerId", buildGetPlayerQuestProgressionHandler(config.GetPlayerQuestProgressionFunc))
 	playerQuests.Patch("/:playerId", buildUpdatePlayerQuestProgressionHandler(config.UpdatePlayerQuestProgressionFunc))
+	playerQuests.Post("/:playerId/migrate", buildMigratePlayerQuestHandler(config.MigratePlayerQuestFunc))
 
 	// Statistic
 	statistics := api.Group("/statistics")
 	statistics.Post("/", buildCreateStatisticHandler(config.CreateStatisticFunc))
 	statistics.Get("/:statisticId", buildGetStatisticHanlder(config.GetStatisticByIDAndGameIDFunc))
 	statistics.Delete("/:statisticId", buildDeleteStatisticHanlder(config.SoftDeleteStatisticByIDAndGameIDFunc))
+	statistics.Post("/:statisticId/restore", buildRestoreStatisticHanlder(config.RestoreStatisticByIDAndGameIDFunc))
 
 	playerStatistics := statistics.Group("/:statisticId/players", buildGetStatisticMiddleware(config.CacheSorage, config.CacheMiddlewareExpiration, config.GetStatisticByIDAndGameIDFunc))
 	playerStatistics.Get("/:playerId", buildGetPlayerStatisticHandler(config.GetPlayerStatisticProgressionFunc))
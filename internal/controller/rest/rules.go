@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type Rejection struct {
+	ID         string    `json:"id"`         // Rejection ID
+	PlayerID   string    `json:"playerId"`   // Player's ID
+	Period     string    `json:"period"`     // Ranking period ID that would receive the submission. Empty for non recurring leaderboards
+	Value      float64   `json:"value"`      // Value submitted
+	Reason     string    `json:"reason"`     // Why the submission was rejected
+	GameID     string    `json:"gameId"`     // ID of the game that made the submission
+	Client     string    `json:"client"`     // Client that made the submission
+	RejectedAt time.Time `json:"rejectedAt"` // Time that the submission was rejected
+}
+
+type Rejections struct {
+	Rejections []Rejection `json:"rejections"` // Rejections page, most recent first
+	NextCursor string      `json:"nextCursor"` // Cursor of the next page. Empty on the last one
+}
+
+func rejectionsFromDomain(r leaderboard.Rejections) Rejections {
+	rejections := make([]Rejection, len(r.Rejections))
+	for i, rejection := range r.Rejections {
+		rejections[i] = Rejection{
+			ID:         rejection.ID,
+			PlayerID:   rejection.PlayerID,
+			Period:     rejection.Period,
+			Value:      rejection.Value,
+			Reason:     rejection.Reason,
+			GameID:     rejection.GameID,
+			Client:     rejection.Client,
+			RejectedAt: rejection.RejectedAt,
+		}
+	}
+
+	return Rejections{
+		Rejections: rejections,
+		NextCursor: r.NextCursor,
+	}
+}
+
+var (
+	ErrorResponseValueOutOfBounds   = ErrorResponse{Code: "2.16", Message: "value out of bounds"}
+	ErrorResponseIncreaseTooHigh    = ErrorResponse{Code: "2.17", Message: "increase too high"}
+	ErrorResponseTooManySubmissions = ErrorResponse{Code: "2.18", Message: "too many submissions"}
+)
+
+// @summary Leaderboard Rejections
+// @description Get the submissions rejected by the leaderboard rules paginated, most recent first. Only recorded when the leaderboard rules ask for it
+// @router /api/v1/leaderboards/{leaderboardId}/rejections [GET]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param leaderboardId path string true "Leaderboard ID"
+// @param cursor query string false "Cursor returned by the previous page. Starts from the most recent rejection when empty"
+// @param limit query int false "Number of rejections per page" minimun(1) maximum(500) default(10)
+// @success 200 {object} Rejections
+// @failure 404,422,500 {object} ErrorResponse
+func buildGetRejectionsHandler(rejectionsFunc leaderboard.RejectionsFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			leaderboard = c.Locals("leaderboard").(leaderboard.Leaderboard)
+			cursor      = c.Query("cursor")
+			limit       = c.QueryInt("limit", 10)
+		)
+
+		rejections, err := rejectionsFunc(c.Context(), leaderboard, cursor, int64(limit))
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(rejectionsFromDomain(rejections))
+	}
+}
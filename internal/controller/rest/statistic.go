@@ -184,3 +184,27 @@ func buildDeleteStatisticHanlder(softDeleteStatisticFunc statistic.SoftDeleteByI
 		return c.SendStatus(http.StatusNoContent)
 	}
 }
+
+// @summary Restore Statistic
+// @description Restore a deleted statistic by its id. Statistics are permanently removed once the deletion retention period is over
+// @router /api/v1/statistics/{statisticId}/restore [POST]
+// @produce json
+// @param Authorization header string true "Game's JWT authorization"
+// @param statisticId path string true "Statistic ID"
+// @success 200 {object} Statistic
+// @failure 404,422,500 {object} ErrorResponse
+func buildRestoreStatisticHanlder(restoreStatisticFunc statistic.RestoreByIDAndGameIDFunc) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var (
+			statisticID = c.Params("statisticId")
+			claims      = c.Locals("claims").(auth.Claims)
+		)
+
+		statistic, err := restoreStatisticFunc(c.Context(), statisticID, claims.GameID)
+		if err != nil {
+			return err
+		}
+
+		return c.Status(http.StatusOK).JSON(statisticFromDomain(statistic))
+	}
+}
@@ -10,6 +10,7 @@ type Task struct {
 	CreatedAt             time.Time `json:"createdAt"`             // Time that the task was created
 	UpdatedAt             time.Time `json:"updatedAt"`             // Last time that the task was updated
 	ID                    string    `json:"id"`                    // Task ID
+	Key                   string    `json:"key"`                   // Task key that identifies it across the quest versions
 	Name                  string    `json:"name"`                  // Task name
 	Description           string    `json:"description"`           // Task details
 	DependsOn             []string  `json:"dependsOn,omitempty"`   // IDs from the tasks that needs to be completed before this one can be started
@@ -22,6 +23,7 @@ func taskFromDomain(t quest.Task) Task {
 		CreatedAt:             t.CreatedAt,
 		UpdatedAt:             t.UpdatedAt,
 		ID:                    t.ID,
+		Key:                   t.Key,
 		Name:                  t.Name,
 		Description:           t.Description,
 		DependsOn:             t.DependsOn,
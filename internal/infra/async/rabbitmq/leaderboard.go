@@ -0,0 +1,191 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	leaderboardExchange = "gameblitz.leaderboard"
+
+	leaderboardFinalizedEventType = "leaderboard.finalized"
+	playerRewardedEventType       = "leaderboard.player.rewarded"
+	playerRankChangedEventType    = "leaderboard.player.rank.changed"
+	playerDisplacedEventType      = "leaderboard.player.displaced"
+)
+
+type (
+	RankMessage struct {
+		PlayerID string  `json:"playerId"`
+		Position int64   `json:"position"`
+		Value    float64 `json:"value"`
+	}
+
+	LeaderboardFinalizedMessage struct {
+		LeaderboardID string        `json:"leaderboardId"`
+		GameID        string        `json:"gameId"`
+		Period        string        `json:"period"`
+		FinalizedAt   time.Time     `json:"finalizedAt"`
+		Total         int64         `json:"total"`
+		Top           []RankMessage `json:"top"`
+	}
+
+	PlayerRewardedMessage struct {
+		LeaderboardID string  `json:"leaderboardId"`
+		GameID        string  `json:"gameId"`
+		Period        string  `json:"period"`
+		PlayerID      string  `json:"playerId"`
+		Position      int64   `json:"position"`
+		Value         float64 `json:"value"`
+		Tier          string  `json:"tier"`
+	}
+
+	PlayerRankChangedMessage struct {
+		LeaderboardID    string  `json:"leaderboardId"`
+		GameID           string  `json:"gameId"`
+		Period           string  `json:"period"`
+		PlayerID         string  `json:"playerId"`
+		PreviousPosition *int64  `json:"previousPosition"`
+		Position         int64   `json:"position"`
+		Value            float64 `json:"value"`
+	}
+
+	PlayerDisplacedMessage struct {
+		LeaderboardID string      `json:"leaderboardId"`
+		GameID        string      `json:"gameId"`
+		Period        string      `json:"period"`
+		PlayerID      string      `json:"playerId"`
+		Position      int64       `json:"position"`
+		Value         float64     `json:"value"`
+		Top           int64       `json:"top"`
+		DisplacedBy   RankMessage `json:"displacedBy"`
+	}
+)
+
+func messageFromRanks(ranks []leaderboard.Rank) []RankMessage {
+	messages := make([]RankMessage, len(ranks))
+	for i, rank := range ranks {
+		messages[i] = RankMessage{
+			PlayerID: rank.PlayerID,
+			Position: rank.Position,
+			Value:    rank.Value,
+		}
+	}
+
+	return messages
+}
+
+func messageFromLeaderboardResults(lb leaderboard.Leaderboard, results leaderboard.Results) LeaderboardFinalizedMessage {
+	return LeaderboardFinalizedMessage{
+		LeaderboardID: lb.ID,
+		GameID:        lb.GameID,
+		Period:        results.Period,
+		FinalizedAt:   results.FinalizedAt,
+		Total:         results.Total,
+		Top:           messageFromRanks(results.Ranks),
+	}
+}
+
+func messageFromReward(lb leaderboard.Leaderboard, reward leaderboard.Reward) PlayerRewardedMessage {
+	return PlayerRewardedMessage{
+		LeaderboardID: lb.ID,
+		GameID:        lb.GameID,
+		Period:        reward.Period,
+		PlayerID:      reward.PlayerID,
+		Position:      reward.Position,
+		Value:         reward.Value,
+		Tier:          reward.Tier,
+	}
+}
+
+func messageFromRankChange(lb leaderboard.Leaderboard, change leaderboard.RankChange) PlayerRankChangedMessage {
+	return PlayerRankChangedMessage{
+		LeaderboardID:    lb.ID,
+		GameID:           lb.GameID,
+		Period:           change.Period,
+		PlayerID:         change.PlayerID,
+		PreviousPosition: change.PreviousPosition,
+		Position:         change.Position,
+		Value:            change.Value,
+	}
+}
+
+func messageFromDisplaced(lb leaderboard.Leaderboard, change leaderboard.RankChange, displaced leaderboard.Rank) PlayerDisplacedMessage {
+	return PlayerDisplacedMessage{
+		LeaderboardID: lb.ID,
+		GameID:        lb.GameID,
+		Period:        change.Period,
+		PlayerID:      displaced.PlayerID,
+		Position:      displaced.Position,
+		Value:         displaced.Value,
+		Top:           lb.NotifyTop,
+		DisplacedBy: RankMessage{
+			PlayerID: change.PlayerID,
+			Position: change.Position,
+			Value:    change.Value,
+		},
+	}
+}
+
+func buildLeaderboardRoutingKey(gameID, leaderboardID string) string {
+	return fmt.Sprintf("game.%s.leaderboard.%s", gameID, leaderboardID)
+}
+
+// Builds the ID of an event that must be handled once, so consumers can drop the copies published when a
+// finalization is retried
+func buildLeaderboardMessageID(eventType string, parts ...string) string {
+	return fmt.Sprintf("%s:%s", eventType, strings.Join(parts, ":"))
+}
+
+func (p producer) ensureLeaderboardExchange(ctx context.Context) error {
+	return p.declareExchange(ctx, leaderboardExchange)
+}
+
+func (p producer) publishLeaderboardEvent(ctx context.Context, lb leaderboard.Leaderboard, eventType, messageID string, message any) error {
+	var (
+		routingKey = buildLeaderboardRoutingKey(lb.GameID, lb.ID)
+		mandatory  = false
+		immediate  = false
+	)
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	ch, err := p.getChannel()
+	if err != nil {
+		return err
+	}
+
+	// The exchange carries every kind of leaderboard event, so consumers tell them apart by its type
+	return ch.PublishWithContext(ctx, leaderboardExchange, routingKey, mandatory, immediate, amqp.Publishing{
+		ContentType: "application/json",
+		Type:        eventType,
+		MessageId:   messageID,
+		Body:        body,
+	})
+}
+
+func (p producer) LeaderboardFinalized(ctx context.Context, lb leaderboard.Leaderboard, results leaderboard.Results) error {
+	return p.publishLeaderboardEvent(ctx, lb, leaderboardFinalizedEventType, buildLeaderboardMessageID(leaderboardFinalizedEventType, lb.ID, results.Period), messageFromLeaderboardResults(lb, results))
+}
+
+func (p producer) PlayerRewarded(ctx context.Context, lb leaderboard.Leaderboard, reward leaderboard.Reward) error {
+	return p.publishLeaderboardEvent(ctx, lb, playerRewardedEventType, buildLeaderboardMessageID(playerRewardedEventType, lb.ID, reward.Period, reward.PlayerID), messageFromReward(lb, reward))
+}
+
+func (p producer) PlayerRankChanged(ctx context.Context, lb leaderboard.Leaderboard, change leaderboard.RankChange) error {
+	return p.publishLeaderboardEvent(ctx, lb, playerRankChangedEventType, "", messageFromRankChange(lb, change))
+}
+
+func (p producer) PlayerDisplaced(ctx context.Context, lb leaderboard.Leaderboard, change leaderboard.RankChange, displaced leaderboard.Rank) error {
+	return p.publishLeaderboardEvent(ctx, lb, playerDisplacedEventType, "", messageFromDisplaced(lb, change, displaced))
+}
@@ -34,6 +34,10 @@ func (p producer) ensureExchanges(ctx context.Context) error {
 		return fmt.Errorf("Quest Exchange: %w", err)
 	}
 
+	if err := p.ensureLeaderboardExchange(ctx); err != nil {
+		return fmt.Errorf("Leaderboard Exchange: %w", err)
+	}
+
 	return nil
 }
 
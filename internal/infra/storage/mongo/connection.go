@@ -19,6 +19,14 @@ func (c connection) ensureIndexes(ctx context.Context) error {
 		return fmt.Errorf("Player Statistics: %w", err)
 	}
 
+	if err := c.ensureLeaderboardResultsIndexes(ctx); err != nil {
+		return fmt.Errorf("Leaderboard Results: %w", err)
+	}
+
+	if err := c.ensureLeaderboardRewardsIndexes(ctx); err != nil {
+		return fmt.Errorf("Leaderboard Rewards: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,226 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	leaderboardResultsCollectionName      = "leaderboardsResults"
+	leaderboardResultsRanksCollectionName = "leaderboardsResultsRanks"
+)
+
+type LeaderboardResults struct {
+	LeaderboardID string    `bson:"leaderboardId"`
+	Period        string    `bson:"period"`
+	FinalizedAt   time.Time `bson:"finalizedAt"`
+	Total         int64     `bson:"total"`
+}
+
+type LeaderboardResultsRank struct {
+	LeaderboardID string  `bson:"leaderboardId"`
+	Period        string  `bson:"period"`
+	Order         int64   `bson:"order"`
+	PlayerID      string  `bson:"playerId"`
+	Position      int64   `bson:"position"`
+	Value         float64 `bson:"value"`
+}
+
+func (r LeaderboardResultsRank) toDomain() leaderboard.Rank {
+	return leaderboard.Rank{
+		LeaderboardID: r.LeaderboardID,
+		PlayerID:      r.PlayerID,
+		Position:      r.Position,
+		Value:         r.Value,
+	}
+}
+
+func (c connection) ensureLeaderboardResultsIndexes(ctx context.Context) error {
+	_, err := c.client.Database(c.db).Collection(leaderboardResultsCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "leaderboardId", Value: 1},
+			{Key: "period", Value: 1},
+		},
+		Options: options.Index().SetName("leaderboardId_1_period_1").SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Database(c.db).Collection(leaderboardResultsRanksCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "leaderboardId", Value: 1},
+			{Key: "period", Value: 1},
+			{Key: "order", Value: 1},
+		},
+		Options: options.Index().SetName("leaderboardId_1_period_1_order_1").SetUnique(true),
+	})
+
+	return err
+}
+
+func (c connection) AppendLeaderboardResults(ctx context.Context, leaderboardID, period string, offset int64, ranks []leaderboard.Rank) error {
+	models := make([]mongo.WriteModel, len(ranks))
+	for i, rank := range ranks {
+		data := LeaderboardResultsRank{
+			LeaderboardID: leaderboardID,
+			Period:        period,
+			Order:         offset + int64(i),
+			PlayerID:      rank.PlayerID,
+			Position:      rank.Position,
+			Value:         rank.Value,
+		}
+
+		// Replacing keeps the append idempotent when a failed finalization is retried
+		models[i] = mongo.NewReplaceOneModel().
+			SetFilter(bson.M{
+				"leaderboardId": bson.M{"$eq": data.LeaderboardID},
+				"period":        bson.M{"$eq": data.Period},
+				"order":         bson.M{"$eq": data.Order},
+			}).
+			SetReplacement(data).
+			SetUpsert(true)
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := c.client.Database(c.db).Collection(leaderboardResultsRanksCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (c connection) SaveLeaderboardResults(ctx context.Context, results leaderboard.Results) error {
+	data := LeaderboardResults{
+		LeaderboardID: results.LeaderboardID,
+		Period:        results.Period,
+		FinalizedAt:   results.FinalizedAt.UTC(),
+		Total:         results.Total,
+	}
+
+	filter := bson.M{
+		"leaderboardId": bson.M{"$eq": data.LeaderboardID},
+		"period":        bson.M{"$eq": data.Period},
+	}
+
+	_, err := c.client.Database(c.db).Collection(leaderboardResultsCollectionName).ReplaceOne(ctx, filter, data, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (c connection) GetLeaderboardResults(ctx context.Context, leaderboardID, period string, page, limit int64) (leaderboard.Results, error) {
+	filter := bson.M{
+		"leaderboardId": bson.M{"$eq": leaderboardID},
+		"period":        bson.M{"$eq": period},
+	}
+
+	cursor := c.client.Database(c.db).Collection(leaderboardResultsCollectionName).FindOne(ctx, filter)
+	if err := cursor.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = leaderboard.ErrResultsNotFound
+		}
+
+		return leaderboard.Results{}, err
+	}
+
+	var results LeaderboardResults
+	if err := cursor.Decode(&results); err != nil {
+		return leaderboard.Results{}, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "order", Value: 1}}).
+		SetSkip(page * limit).
+		SetLimit(limit)
+
+	ranksCursor, err := c.client.Database(c.db).Collection(leaderboardResultsRanksCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return leaderboard.Results{}, err
+	}
+
+	var ranks []LeaderboardResultsRank
+	if err := ranksCursor.All(ctx, &ranks); err != nil {
+		return leaderboard.Results{}, err
+	}
+
+	domainRanks := make([]leaderboard.Rank, len(ranks))
+	for i, rank := range ranks {
+		domainRanks[i] = rank.toDomain()
+	}
+
+	return leaderboard.Results{
+		LeaderboardID: results.LeaderboardID,
+		Period:        results.Period,
+		FinalizedAt:   results.FinalizedAt,
+		Total:         results.Total,
+		Ranks:         domainRanks,
+	}, nil
+}
+
+// Walks the index on the ranks order with a single cursor, so the memory used is bounded by the chunk size
+func (c connection) StreamLeaderboardResults(ctx context.Context, leaderboardID, period string, chunkSize int64, write func(ranks []leaderboard.Rank) error) error {
+	filter := bson.M{
+		"leaderboardId": bson.M{"$eq": leaderboardID},
+		"period":        bson.M{"$eq": period},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "order", Value: 1}}).
+		SetBatchSize(int32(chunkSize))
+
+	cursor, err := c.client.Database(c.db).Collection(leaderboardResultsRanksCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	ranks := make([]leaderboard.Rank, 0, chunkSize)
+	for cursor.Next(ctx) {
+		var rank LeaderboardResultsRank
+		if err := cursor.Decode(&rank); err != nil {
+			return err
+		}
+
+		ranks = append(ranks, rank.toDomain())
+		if int64(len(ranks)) < chunkSize {
+			continue
+		}
+
+		if err := write(ranks); err != nil {
+			return err
+		}
+
+		ranks = make([]leaderboard.Rank, 0, chunkSize)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	if len(ranks) == 0 {
+		return nil
+	}
+
+	return write(ranks)
+}
+
+// Removes the results of every period, along with the rewards granted by them
+func (c connection) PurgeLeaderboardResults(ctx context.Context, leaderboardID string) error {
+	filter := bson.M{
+		"leaderboardId": bson.M{"$eq": leaderboardID},
+	}
+
+	for _, collection := range []string{leaderboardRewardsCollectionName, leaderboardResultsRanksCollectionName, leaderboardResultsCollectionName} {
+		if _, err := c.client.Database(c.db).Collection(collection).DeleteMany(ctx, filter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const leaderboardRewardsCollectionName = "leaderboardsRewards"
+
+type LeaderboardReward struct {
+	LeaderboardID string  `bson:"leaderboardId"`
+	Period        string  `bson:"period"`
+	PlayerID      string  `bson:"playerId"`
+	Position      int64   `bson:"position"`
+	Value         float64 `bson:"value"`
+	Tier          string  `bson:"tier"`
+}
+
+func (r LeaderboardReward) toDomain() leaderboard.Reward {
+	return leaderboard.Reward{
+		LeaderboardID: r.LeaderboardID,
+		Period:        r.Period,
+		PlayerID:      r.PlayerID,
+		Position:      r.Position,
+		Value:         r.Value,
+		Tier:          r.Tier,
+	}
+}
+
+func (c connection) ensureLeaderboardRewardsIndexes(ctx context.Context) error {
+	_, err := c.client.Database(c.db).Collection(leaderboardRewardsCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "leaderboardId", Value: 1},
+			{Key: "period", Value: 1},
+			{Key: "playerId", Value: 1},
+		},
+		Options: options.Index().SetName("leaderboardId_1_period_1_playerId_1").SetUnique(true),
+	})
+
+	return err
+}
+
+func (c connection) SaveLeaderboardRewards(ctx context.Context, rewards []leaderboard.Reward) error {
+	models := make([]mongo.WriteModel, len(rewards))
+	for i, reward := range rewards {
+		data := LeaderboardReward{
+			LeaderboardID: reward.LeaderboardID,
+			Period:        reward.Period,
+			PlayerID:      reward.PlayerID,
+			Position:      reward.Position,
+			Value:         reward.Value,
+			Tier:          reward.Tier,
+		}
+
+		models[i] = mongo.NewReplaceOneModel().
+			SetFilter(bson.M{
+				"leaderboardId": bson.M{"$eq": data.LeaderboardID},
+				"period":        bson.M{"$eq": data.Period},
+				"playerId":      bson.M{"$eq": data.PlayerID},
+			}).
+			SetReplacement(data).
+			SetUpsert(true)
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := c.client.Database(c.db).Collection(leaderboardRewardsCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (c connection) GetLeaderboardPlayerReward(ctx context.Context, leaderboardID, period, playerID string) (leaderboard.Reward, error) {
+	filter := bson.M{
+		"leaderboardId": bson.M{"$eq": leaderboardID},
+		"period":        bson.M{"$eq": period},
+		"playerId":      bson.M{"$eq": playerID},
+	}
+
+	cursor := c.client.Database(c.db).Collection(leaderboardRewardsCollectionName).FindOne(ctx, filter)
+	if err := cursor.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = leaderboard.ErrRewardNotFound
+		}
+
+		return leaderboard.Reward{}, err
+	}
+
+	var reward LeaderboardReward
+	if err := cursor.Decode(&reward); err != nil {
+		return leaderboard.Reward{}, err
+	}
+
+	return reward.toDomain(), nil
+}
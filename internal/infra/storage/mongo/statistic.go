@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const statisticCollectionName = "statistics"
@@ -98,6 +99,25 @@ func (c connection) GetStatisticByIDAndGameID(ctx context.Context, id, gameID st
 	return data.toDomain(), nil
 }
 
+func (c connection) StatisticExists(ctx context.Context, gameID, id string) (bool, error) {
+	// Invalid ids can't belong to any statistic
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, nil
+	}
+
+	count, err := c.client.Database(c.db).Collection(statisticCollectionName).CountDocuments(ctx, bson.M{
+		"_id":       bson.M{"$eq": oid},
+		"gameId":    bson.M{"$eq": gameID},
+		"deletedAt": nil,
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 func (c connection) SoftDeleteStatistic(ctx context.Context, id, gameID string) error {
 	oid, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -127,3 +147,85 @@ func (c connection) SoftDeleteStatistic(ctx context.Context, id, gameID string)
 
 	return nil
 }
+
+func (c connection) RestoreStatistic(ctx context.Context, id, gameID string) (statistic.Statistic, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return statistic.Statistic{}, statistic.ErrInvalidStatisticID
+	}
+
+	filter := bson.M{
+		"_id":       bson.M{"$eq": oid},
+		"gameId":    bson.M{"$eq": gameID},
+		"deletedAt": bson.M{"$ne": nil},
+	}
+
+	update := bson.M{
+		"$unset": bson.M{
+			"deletedAt": "",
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	cursor := c.client.Database(c.db).Collection(statisticCollectionName).FindOneAndUpdate(ctx, filter, update, opts)
+	if err := cursor.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = statistic.ErrStatisticNotFound
+		}
+
+		return statistic.Statistic{}, err
+	}
+
+	var data Statistic
+	if err := cursor.Decode(&data); err != nil {
+		return statistic.Statistic{}, err
+	}
+
+	return data.toDomain(), nil
+}
+
+func (c connection) PurgeDeletedStatistics(ctx context.Context, deletedBefore time.Time) error {
+	filter := bson.M{
+		"deletedAt": bson.M{"$lt": deletedBefore},
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+
+	cursor, err := c.client.Database(c.db).Collection(statisticCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+
+	var statistics []Statistic
+	if err := cursor.All(ctx, &statistics); err != nil {
+		return err
+	}
+
+	if len(statistics) == 0 {
+		return nil
+	}
+
+	var (
+		ids          = make([]primitive.ObjectID, len(statistics))
+		statisticIDs = make([]string, len(statistics))
+	)
+	for i, st := range statistics {
+		ids[i] = st.ID
+		statisticIDs[i] = st.ID.Hex()
+	}
+
+	// Players progression goes first, so the statistics are found again on the next run if it fails
+	_, err = c.client.Database(c.db).Collection(playerStatisticCollectionName).DeleteMany(ctx, bson.M{
+		"statisticId": bson.M{"$in": statisticIDs},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Database(c.db).Collection(statisticCollectionName).DeleteMany(ctx, bson.M{
+		"_id": bson.M{"$in": ids},
+	})
+
+	return err
+}
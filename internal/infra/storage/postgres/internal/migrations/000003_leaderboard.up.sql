@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS "leaderboards" (
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "deleted_at" TIMESTAMPTZ DEFAULT NULL,
+    "id" UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    "game_id" VARCHAR NOT NULL,
+    "name" VARCHAR NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "start_at" TIMESTAMPTZ NOT NULL,
+    "end_at" TIMESTAMPTZ DEFAULT NULL,
+    "aggregation_mode" VARCHAR NOT NULL,
+    "ordering" VARCHAR NOT NULL,
+    "ranking_mode" VARCHAR NOT NULL,
+    "tie_break_policy" VARCHAR NOT NULL,
+    "recurrence" VARCHAR NOT NULL,
+    "timezone" VARCHAR NOT NULL DEFAULT '',
+    "reward_tiers" JSONB NOT NULL DEFAULT '[]',
+    "tiers" JSONB NOT NULL DEFAULT '[]',
+    "scopes" JSONB NOT NULL DEFAULT '[]',
+    "submission_rules" JSONB NOT NULL DEFAULT '{}',
+    "rolling_window" BIGINT NOT NULL DEFAULT 0, -- Rolling window, in seconds
+    "notify_top" BIGINT NOT NULL DEFAULT 0,
+    "statistic_id" VARCHAR NOT NULL DEFAULT '',
+
+    CONSTRAINT "name_not_empty_check" CHECK (TRIM("name") <> ''),
+    CONSTRAINT "game_id_not_empty_check" CHECK (TRIM("game_id") <> ''),
+    CONSTRAINT "rolling_window_not_negative_check" CHECK ("rolling_window" >= 0),
+    CONSTRAINT "notify_top_not_negative_check" CHECK ("notify_top" >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS "idx_leaderboard_created_at" ON "leaderboards" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_leaderboard_deleted_at" ON "leaderboards" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_leaderboard_game_id" ON "leaderboards" ("game_id");
+CREATE INDEX IF NOT EXISTS "idx_leaderboard_game_id_statistic_id" ON "leaderboards" ("game_id", "statistic_id") WHERE "statistic_id" <> '';
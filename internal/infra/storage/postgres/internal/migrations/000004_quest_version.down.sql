@@ -0,0 +1,34 @@
+CREATE OR REPLACE FUNCTION validate_task_belongs_to_quest("task_id" UUID, "player_quest_id" UUID) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1
+        FROM "tasks" t
+        JOIN "quests" q ON q."id" = t."quest_id"
+        WHERE
+            t."id" = "task_id" AND
+            q."id" = (SELECT "quest_id" FROM "player_quests" WHERE "id" = "player_quest_id")
+    );
+END;
+$$ LANGUAGE plpgsql;
+
+-- Only the latest version of each quest is kept. The progression of the players pinned to older versions is lost
+DELETE FROM "tasks" t
+USING "quests" q
+WHERE q."id" = t."quest_id" AND t."version" <> q."version";
+
+DROP VIEW IF EXISTS "tasks_with_its_dependencies";
+
+ALTER TABLE "player_quests" DROP COLUMN IF EXISTS "quest_version";
+
+ALTER TABLE "tasks" DROP CONSTRAINT IF EXISTS "task_key_unique";
+ALTER TABLE "tasks" DROP COLUMN IF EXISTS "key";
+ALTER TABLE "tasks" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "quests" DROP COLUMN IF EXISTS "version";
+
+CREATE VIEW "tasks_with_its_dependencies" AS
+    SELECT t.*, ARRAY_REMOVE(ARRAY_AGG(td."depends_on_task"), NULL)::UUID[] AS "depends_on" 
+    FROM "tasks" t
+    LEFT JOIN "tasks_dependencies" td on t."id" = td."this_task"
+    GROUP BY t."id"
+    ORDER BY t."created_at" ASC;
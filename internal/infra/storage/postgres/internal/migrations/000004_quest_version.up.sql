@@ -0,0 +1,32 @@
+ALTER TABLE "quests" ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+
+-- Tasks created before the versioning are keyed by their ID
+ALTER TABLE "tasks" ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE "tasks" ADD COLUMN IF NOT EXISTS "key" VARCHAR;
+UPDATE "tasks" SET "key" = "id"::VARCHAR WHERE "key" IS NULL;
+ALTER TABLE "tasks" ALTER COLUMN "key" SET NOT NULL;
+ALTER TABLE "tasks" ADD CONSTRAINT "task_key_unique" UNIQUE ("quest_id", "version", "key");
+
+ALTER TABLE "player_quests" ADD COLUMN IF NOT EXISTS "quest_version" INTEGER NOT NULL DEFAULT 1;
+
+-- Recreated since the view columns are fixed when it's created
+DROP VIEW IF EXISTS "tasks_with_its_dependencies";
+CREATE VIEW "tasks_with_its_dependencies" AS
+    SELECT t.*, ARRAY_REMOVE(ARRAY_AGG(td."depends_on_task"), NULL)::UUID[] AS "depends_on" 
+    FROM "tasks" t
+    LEFT JOIN "tasks_dependencies" td on t."id" = td."this_task"
+    GROUP BY t."id"
+    ORDER BY t."created_at" ASC;
+
+CREATE OR REPLACE FUNCTION validate_task_belongs_to_quest("task_id" UUID, "player_quest_id" UUID) RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN EXISTS (
+        SELECT 1
+        FROM "tasks" t
+        JOIN "player_quests" pq ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version"
+        WHERE
+            t."id" = "task_id" AND
+            pq."id" = "player_quest_id"
+    );
+END;
+$$ LANGUAGE plpgsql;
@@ -9,13 +9,39 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Leaderboard struct {
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+	ID              uuid.UUID
+	GameID          string
+	Name            string
+	Description     string
+	StartAt         pgtype.Timestamptz
+	EndAt           pgtype.Timestamptz
+	AggregationMode string
+	Ordering        string
+	RankingMode     string
+	TieBreakPolicy  string
+	Recurrence      string
+	Timezone        string
+	RewardTiers     []byte
+	Tiers           []byte
+	Scopes          []byte
+	SubmissionRules []byte
+	RollingWindow   int64
+	NotifyTop       int64
+	StatisticID     string
+}
+
 type PlayerQuest struct {
-	StartedAt   pgtype.Timestamptz
-	UpdatedAt   pgtype.Timestamptz
-	ID          uuid.UUID
-	PlayerID    string
-	QuestID     uuid.UUID
-	CompletedAt pgtype.Timestamptz
+	StartedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	ID           uuid.UUID
+	PlayerID     string
+	QuestID      uuid.UUID
+	CompletedAt  pgtype.Timestamptz
+	QuestVersion int32
 }
 
 type PlayerQuestTask struct {
@@ -36,6 +62,7 @@ type Quest struct {
 	GameID      string
 	Name        string
 	Description string
+	Version     int32
 }
 
 type Task struct {
@@ -48,6 +75,8 @@ type Task struct {
 	Description           string
 	RequiredForCompletion bool
 	Rule                  string
+	Version               int32
+	Key                   string
 }
 
 type TasksDependency struct {
@@ -65,5 +94,7 @@ type TasksWithItsDependency struct {
 	Description           string
 	RequiredForCompletion bool
 	Rule                  string
+	Version               int32
+	Key                   string
 	DependsOn             []uuid.UUID
 }
@@ -12,9 +12,82 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countPlayerQuestsByGameID = `-- name: CountPlayerQuestsByGameID :one
+SELECT COUNT(*)
+FROM "player_quests" pq
+JOIN "quests" q ON q."id" = pq."quest_id"
+WHERE
+    pq."player_id" = $1 AND
+    q."game_id" = $2 AND
+    q."deleted_at" IS NULL
+`
+
+type CountPlayerQuestsByGameIDParams struct {
+	PlayerID string
+	GameID   string
+}
+
+// CountPlayerQuestsByGameID
+//
+//	SELECT COUNT(*)
+//	FROM "player_quests" pq
+//	JOIN "quests" q ON q."id" = pq."quest_id"
+//	WHERE
+//	    pq."player_id" = $1 AND
+//	    q."game_id" = $2 AND
+//	    q."deleted_at" IS NULL
+func (q *Queries) CountPlayerQuestsByGameID(ctx context.Context, arg CountPlayerQuestsByGameIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPlayerQuestsByGameID, arg.PlayerID, arg.GameID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPlayerQuestTask = `-- name: CreatePlayerQuestTask :exec
+INSERT INTO "player_quest_tasks" ("started_at", "player_id", "player_quest_id", "task_id", "completed_at")
+VALUES (COALESCE($1::TIMESTAMPTZ, NOW()), $2, $3, $4, $5)
+`
+
+type CreatePlayerQuestTaskParams struct {
+	StartedAt     pgtype.Timestamptz
+	PlayerID      string
+	PlayerQuestID uuid.UUID
+	TaskID        uuid.UUID
+	CompletedAt   pgtype.Timestamptz
+}
+
+// CreatePlayerQuestTask
+//
+//	INSERT INTO "player_quest_tasks" ("started_at", "player_id", "player_quest_id", "task_id", "completed_at")
+//	VALUES (COALESCE($1::TIMESTAMPTZ, NOW()), $2, $3, $4, $5)
+func (q *Queries) CreatePlayerQuestTask(ctx context.Context, arg CreatePlayerQuestTaskParams) error {
+	_, err := q.db.Exec(ctx, createPlayerQuestTask,
+		arg.StartedAt,
+		arg.PlayerID,
+		arg.PlayerQuestID,
+		arg.TaskID,
+		arg.CompletedAt,
+	)
+	return err
+}
+
+const deletePlayerQuestTasks = `-- name: DeletePlayerQuestTasks :exec
+DELETE FROM "player_quest_tasks"
+WHERE "player_quest_id" = $1
+`
+
+// DeletePlayerQuestTasks
+//
+//	DELETE FROM "player_quest_tasks"
+//	WHERE "player_quest_id" = $1
+func (q *Queries) DeletePlayerQuestTasks(ctx context.Context, playerQuestID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePlayerQuestTasks, playerQuestID)
+	return err
+}
+
 const getPlayerQuest = `-- name: GetPlayerQuest :one
 
-SELECT started_at, updated_at, id, player_id, quest_id, completed_at
+SELECT started_at, updated_at, id, player_id, quest_id, completed_at, quest_version
 FROM "player_quests" pq
 WHERE pq."player_id" = $1 AND pq."quest_id" = $2
 `
@@ -28,7 +101,7 @@ type GetPlayerQuestParams struct {
 // Get Player Quests --
 // ---------------------
 //
-//	SELECT started_at, updated_at, id, player_id, quest_id, completed_at
+//	SELECT started_at, updated_at, id, player_id, quest_id, completed_at, quest_version
 //	FROM "player_quests" pq
 //	WHERE pq."player_id" = $1 AND pq."quest_id" = $2
 func (q *Queries) GetPlayerQuest(ctx context.Context, arg GetPlayerQuestParams) (PlayerQuest, error) {
@@ -41,12 +114,13 @@ func (q *Queries) GetPlayerQuest(ctx context.Context, arg GetPlayerQuestParams)
 		&i.PlayerID,
 		&i.QuestID,
 		&i.CompletedAt,
+		&i.QuestVersion,
 	)
 	return i, err
 }
 
 const getPlayerQuestTasks = `-- name: GetPlayerQuestTasks :many
-SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.depends_on
+SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.version, t.key, t.depends_on
 FROM "player_quest_tasks" pqt
 JOIN "tasks_with_its_dependencies" t ON t."id" = pqt."task_id"
 WHERE pqt."player_id" = $1 AND t."quest_id" = $2
@@ -70,7 +144,7 @@ type GetPlayerQuestTasksRow struct {
 
 // GetPlayerQuestTasks
 //
-//	SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.depends_on
+//	SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.version, t.key, t.depends_on
 //	FROM "player_quest_tasks" pqt
 //	JOIN "tasks_with_its_dependencies" t ON t."id" = pqt."task_id"
 //	WHERE pqt."player_id" = $1 AND t."quest_id" = $2
@@ -100,6 +174,8 @@ func (q *Queries) GetPlayerQuestTasks(ctx context.Context, arg GetPlayerQuestTas
 			&i.TasksWithItsDependency.Description,
 			&i.TasksWithItsDependency.RequiredForCompletion,
 			&i.TasksWithItsDependency.Rule,
+			&i.TasksWithItsDependency.Version,
+			&i.TasksWithItsDependency.Key,
 			&i.TasksWithItsDependency.DependsOn,
 		); err != nil {
 			return nil, err
@@ -112,10 +188,119 @@ func (q *Queries) GetPlayerQuestTasks(ctx context.Context, arg GetPlayerQuestTas
 	return items, nil
 }
 
+const listPlayerQuestsByGameID = `-- name: ListPlayerQuestsByGameID :many
+
+SELECT
+    pq.started_at, pq.updated_at, pq.id, pq.player_id, pq.quest_id, pq.completed_at, pq.quest_version,
+    q.created_at, q.updated_at, q.deleted_at, q.id, q.game_id, q.name, q.description, q.version,
+    (
+        SELECT COUNT(*)
+        FROM "player_quest_tasks" pqt
+        WHERE pqt."player_quest_id" = pq."id" AND pqt."completed_at" IS NOT NULL
+    ) AS "tasks_completed",
+    (
+        SELECT COUNT(*)
+        FROM "tasks" t
+        WHERE t."quest_id" = q."id" AND t."version" = pq."quest_version" AND t."deleted_at" IS NULL
+    ) AS "tasks_total"
+FROM "player_quests" pq
+JOIN "quests" q ON q."id" = pq."quest_id"
+WHERE
+    pq."player_id" = $1 AND
+    q."game_id" = $2 AND
+    q."deleted_at" IS NULL
+ORDER BY pq."started_at" DESC, pq."id" DESC
+LIMIT $3 OFFSET $4
+`
+
+type ListPlayerQuestsByGameIDParams struct {
+	PlayerID string
+	GameID   string
+	Limit    int32
+	Offset   int32
+}
+
+type ListPlayerQuestsByGameIDRow struct {
+	PlayerQuest    PlayerQuest
+	Quest          Quest
+	TasksCompleted int64
+	TasksTotal     int64
+}
+
+// ----------------------
+// List Player Quests --
+// ----------------------
+//
+//	SELECT
+//	    pq.started_at, pq.updated_at, pq.id, pq.player_id, pq.quest_id, pq.completed_at, pq.quest_version,
+//	    q.created_at, q.updated_at, q.deleted_at, q.id, q.game_id, q.name, q.description, q.version,
+//	    (
+//	        SELECT COUNT(*)
+//	        FROM "player_quest_tasks" pqt
+//	        WHERE pqt."player_quest_id" = pq."id" AND pqt."completed_at" IS NOT NULL
+//	    ) AS "tasks_completed",
+//	    (
+//	        SELECT COUNT(*)
+//	        FROM "tasks" t
+//	        WHERE t."quest_id" = q."id" AND t."version" = pq."quest_version" AND t."deleted_at" IS NULL
+//	    ) AS "tasks_total"
+//	FROM "player_quests" pq
+//	JOIN "quests" q ON q."id" = pq."quest_id"
+//	WHERE
+//	    pq."player_id" = $1 AND
+//	    q."game_id" = $2 AND
+//	    q."deleted_at" IS NULL
+//	ORDER BY pq."started_at" DESC, pq."id" DESC
+//	LIMIT $3 OFFSET $4
+func (q *Queries) ListPlayerQuestsByGameID(ctx context.Context, arg ListPlayerQuestsByGameIDParams) ([]ListPlayerQuestsByGameIDRow, error) {
+	rows, err := q.db.Query(ctx, listPlayerQuestsByGameID,
+		arg.PlayerID,
+		arg.GameID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPlayerQuestsByGameIDRow{}
+	for rows.Next() {
+		var i ListPlayerQuestsByGameIDRow
+		if err := rows.Scan(
+			&i.PlayerQuest.StartedAt,
+			&i.PlayerQuest.UpdatedAt,
+			&i.PlayerQuest.ID,
+			&i.PlayerQuest.PlayerID,
+			&i.PlayerQuest.QuestID,
+			&i.PlayerQuest.CompletedAt,
+			&i.PlayerQuest.QuestVersion,
+			&i.Quest.CreatedAt,
+			&i.Quest.UpdatedAt,
+			&i.Quest.DeletedAt,
+			&i.Quest.ID,
+			&i.Quest.GameID,
+			&i.Quest.Name,
+			&i.Quest.Description,
+			&i.Quest.Version,
+			&i.TasksCompleted,
+			&i.TasksTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markPlayerQuestAsCompleted = `-- name: MarkPlayerQuestAsCompleted :exec
 WITH "completion_list" AS (
 	SELECT (pqt."completed_at" IS NOT NULL) AS "completed"
 	FROM "tasks" t
+	JOIN "player_quests" pq
+        ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version" AND pq."player_id" = $2
 	LEFT JOIN "player_quest_tasks" pqt
         ON t.id = pqt."task_id" AND pqt."player_id" = $2
 	WHERE
@@ -143,6 +328,8 @@ type MarkPlayerQuestAsCompletedParams struct {
 //	WITH "completion_list" AS (
 //		SELECT (pqt."completed_at" IS NOT NULL) AS "completed"
 //		FROM "tasks" t
+//		JOIN "player_quests" pq
+//	        ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version" AND pq."player_id" = $2
 //		LEFT JOIN "player_quest_tasks" pqt
 //	        ON t.id = pqt."task_id" AND pqt."player_id" = $2
 //		WHERE
@@ -197,13 +384,58 @@ func (q *Queries) MarkPlayerQuestTasksAsCompleted(ctx context.Context, arg MarkP
 	return err
 }
 
+const migratePlayerQuest = `-- name: MigratePlayerQuest :one
+
+UPDATE "player_quests" pq
+SET
+    "updated_at" = NOW(),
+    "quest_version" = q."version"
+FROM "quests" q
+WHERE
+    q."id" = pq."quest_id" AND
+    pq."id" = $1 AND
+    pq."completed_at" IS NULL AND
+    pq."quest_version" <> q."version"
+RETURNING pq.started_at, pq.updated_at, pq.id, pq.player_id, pq.quest_id, pq.completed_at, pq.quest_version
+`
+
+// ----------------------------------------------
+// Migrate Player Quest To Its Latest Version --
+// ----------------------------------------------
+//
+//	UPDATE "player_quests" pq
+//	SET
+//	    "updated_at" = NOW(),
+//	    "quest_version" = q."version"
+//	FROM "quests" q
+//	WHERE
+//	    q."id" = pq."quest_id" AND
+//	    pq."id" = $1 AND
+//	    pq."completed_at" IS NULL AND
+//	    pq."quest_version" <> q."version"
+//	RETURNING pq.started_at, pq.updated_at, pq.id, pq.player_id, pq.quest_id, pq.completed_at, pq.quest_version
+func (q *Queries) MigratePlayerQuest(ctx context.Context, id uuid.UUID) (PlayerQuest, error) {
+	row := q.db.QueryRow(ctx, migratePlayerQuest, id)
+	var i PlayerQuest
+	err := row.Scan(
+		&i.StartedAt,
+		&i.UpdatedAt,
+		&i.ID,
+		&i.PlayerID,
+		&i.QuestID,
+		&i.CompletedAt,
+		&i.QuestVersion,
+	)
+	return i, err
+}
+
 const startPlayerQuest = `-- name: StartPlayerQuest :one
 
-INSERT INTO "player_quests" ("player_id", "quest_id")
-SELECT $1, q."id"
+INSERT INTO "player_quests" ("player_id", "quest_id", "quest_version")
+SELECT $1, q."id", q."version"
 FROM "quests" q
 WHERE q."id" = $2 AND q."deleted_at" IS NULL
-RETURNING started_at, updated_at, id, player_id, quest_id, completed_at
+RETURNING started_at, updated_at, id, player_id, quest_id, completed_at, quest_version
 `
 
 type StartPlayerQuestParams struct {
@@ -215,11 +447,11 @@ type StartPlayerQuestParams struct {
 // Start Player Quest --
 // ----------------------
 //
-//	INSERT INTO "player_quests" ("player_id", "quest_id")
-//	SELECT $1, q."id"
+//	INSERT INTO "player_quests" ("player_id", "quest_id", "quest_version")
+//	SELECT $1, q."id", q."version"
 //	FROM "quests" q
 //	WHERE q."id" = $2 AND q."deleted_at" IS NULL
-//	RETURNING started_at, updated_at, id, player_id, quest_id, completed_at
+//	RETURNING started_at, updated_at, id, player_id, quest_id, completed_at, quest_version
 func (q *Queries) StartPlayerQuest(ctx context.Context, arg StartPlayerQuestParams) (PlayerQuest, error) {
 	row := q.db.QueryRow(ctx, startPlayerQuest, arg.PlayerID, arg.QuestID)
 	var i PlayerQuest
@@ -230,6 +462,7 @@ func (q *Queries) StartPlayerQuest(ctx context.Context, arg StartPlayerQuestPara
 		&i.PlayerID,
 		&i.QuestID,
 		&i.CompletedAt,
+		&i.QuestVersion,
 	)
 	return i, err
 }
@@ -239,11 +472,11 @@ WITH "player_quest_tasks_created" AS (
     INSERT INTO "player_quest_tasks" ("player_id", "player_quest_id", "task_id")
     SELECT pq."player_id", $1, t."id"
     FROM "player_quests" pq
-    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id"
+    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id" AND t."version" = pq."quest_version"
     WHERE pq."id" = $1 AND ARRAY_LENGTH(t."depends_on", 1) IS NULL
     RETURNING started_at, updated_at, id, player_id, player_quest_id, task_id, completed_at
 )
-SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, twd.created_at, twd.updated_at, twd.deleted_at, twd.quest_id, twd.id, twd.name, twd.description, twd.required_for_completion, twd.rule, twd.depends_on
+SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, twd.created_at, twd.updated_at, twd.deleted_at, twd.quest_id, twd.id, twd.name, twd.description, twd.required_for_completion, twd.rule, twd.version, twd.key, twd.depends_on
 FROM "player_quest_tasks_created" pqt
 JOIN "tasks_with_its_dependencies" twd ON twd."id" = pqt."task_id"
 `
@@ -265,11 +498,11 @@ type StartPlayerTasksForQuestRow struct {
 //	    INSERT INTO "player_quest_tasks" ("player_id", "player_quest_id", "task_id")
 //	    SELECT pq."player_id", $1, t."id"
 //	    FROM "player_quests" pq
-//	    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id"
+//	    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id" AND t."version" = pq."quest_version"
 //	    WHERE pq."id" = $1 AND ARRAY_LENGTH(t."depends_on", 1) IS NULL
 //	    RETURNING started_at, updated_at, id, player_id, player_quest_id, task_id, completed_at
 //	)
-//	SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, twd.created_at, twd.updated_at, twd.deleted_at, twd.quest_id, twd.id, twd.name, twd.description, twd.required_for_completion, twd.rule, twd.depends_on
+//	SELECT pqt.started_at, pqt.updated_at, pqt.id, pqt.player_id, pqt.player_quest_id, pqt.task_id, pqt.completed_at, twd.created_at, twd.updated_at, twd.deleted_at, twd.quest_id, twd.id, twd.name, twd.description, twd.required_for_completion, twd.rule, twd.version, twd.key, twd.depends_on
 //	FROM "player_quest_tasks_created" pqt
 //	JOIN "tasks_with_its_dependencies" twd ON twd."id" = pqt."task_id"
 func (q *Queries) StartPlayerTasksForQuest(ctx context.Context, playerQuestID uuid.UUID) ([]StartPlayerTasksForQuestRow, error) {
@@ -298,6 +531,8 @@ func (q *Queries) StartPlayerTasksForQuest(ctx context.Context, playerQuestID uu
 			&i.TasksWithItsDependency.Description,
 			&i.TasksWithItsDependency.RequiredForCompletion,
 			&i.TasksWithItsDependency.Rule,
+			&i.TasksWithItsDependency.Version,
+			&i.TasksWithItsDependency.Key,
 			&i.TasksWithItsDependency.DependsOn,
 		); err != nil {
 			return nil, err
@@ -321,8 +556,11 @@ WITH "pq_tasks_status" AS (
 ), "pq_pending_tasks" AS (
 	SELECT t."id"
 	FROM "tasks" t
+	JOIN "player_quests" pq
+	    ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version"
 	WHERE
 	    t."quest_id" = $1 AND
+	    pq."player_id" = $2 AND
 	    t."id" NOT IN (SELECT "task_id" FROM "pq_tasks_status")
 ), "pq_tasks_ready_to_start" AS (
     SELECT td."this_task" AS "id"
@@ -362,8 +600,11 @@ type StartPlayerTasksThatHadTheDependenciesCompletedParams struct {
 //	), "pq_pending_tasks" AS (
 //		SELECT t."id"
 //		FROM "tasks" t
+//		JOIN "player_quests" pq
+//		    ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version"
 //		WHERE
 //		    t."quest_id" = $1 AND
+//		    pq."player_id" = $2 AND
 //		    t."id" NOT IN (SELECT "task_id" FROM "pq_tasks_status")
 //	), "pq_tasks_ready_to_start" AS (
 //	    SELECT td."this_task" AS "id"
@@ -9,12 +9,55 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countQuestsByGameID = `-- name: CountQuestsByGameID :one
+SELECT COUNT(*)
+FROM "quests" q
+WHERE
+    q."game_id" = $1 AND
+    (q."deleted_at" IS NOT NULL) = $2::BOOLEAN AND
+    q."name" ILIKE '%' || $3::VARCHAR || '%' AND
+    q."created_at" >= COALESCE($4::TIMESTAMPTZ, '-infinity') AND
+    q."created_at" < COALESCE($5::TIMESTAMPTZ, 'infinity')
+`
+
+type CountQuestsByGameIDParams struct {
+	GameID        string
+	Deleted       bool
+	Search        string
+	CreatedAfter  pgtype.Timestamptz
+	CreatedBefore pgtype.Timestamptz
+}
+
+// CountQuestsByGameID
+//
+//	SELECT COUNT(*)
+//	FROM "quests" q
+//	WHERE
+//	    q."game_id" = $1 AND
+//	    (q."deleted_at" IS NOT NULL) = $2::BOOLEAN AND
+//	    q."name" ILIKE '%' || $3::VARCHAR || '%' AND
+//	    q."created_at" >= COALESCE($4::TIMESTAMPTZ, '-infinity') AND
+//	    q."created_at" < COALESCE($5::TIMESTAMPTZ, 'infinity')
+func (q *Queries) CountQuestsByGameID(ctx context.Context, arg CountQuestsByGameIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countQuestsByGameID,
+		arg.GameID,
+		arg.Deleted,
+		arg.Search,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createQuest = `-- name: CreateQuest :one
 INSERT INTO "quests" ("game_id", "name", "description")
 VALUES ($1, $2, $3)
-RETURNING created_at, updated_at, deleted_at, id, game_id, name, description
+RETURNING created_at, updated_at, deleted_at, id, game_id, name, description, version
 `
 
 type CreateQuestParams struct {
@@ -27,7 +70,7 @@ type CreateQuestParams struct {
 //
 //	INSERT INTO "quests" ("game_id", "name", "description")
 //	VALUES ($1, $2, $3)
-//	RETURNING created_at, updated_at, deleted_at, id, game_id, name, description
+//	RETURNING created_at, updated_at, deleted_at, id, game_id, name, description, version
 func (q *Queries) CreateQuest(ctx context.Context, arg CreateQuestParams) (Quest, error) {
 	row := q.db.QueryRow(ctx, createQuest, arg.GameID, arg.Name, arg.Description)
 	var i Quest
@@ -39,12 +82,68 @@ func (q *Queries) CreateQuest(ctx context.Context, arg CreateQuestParams) (Quest
 		&i.GameID,
 		&i.Name,
 		&i.Description,
+		&i.Version,
+	)
+	return i, err
+}
+
+const createQuestVersion = `-- name: CreateQuestVersion :one
+UPDATE "quests"
+SET
+    "updated_at" = NOW(),
+    "version" = "version" + 1,
+    "name" = $3,
+    "description" = $4
+WHERE
+    "id" = $1 AND
+    "game_id" = $2 AND
+    "deleted_at" IS NULL
+RETURNING created_at, updated_at, deleted_at, id, game_id, name, description, version
+`
+
+type CreateQuestVersionParams struct {
+	ID          uuid.UUID
+	GameID      string
+	Name        string
+	Description string
+}
+
+// CreateQuestVersion
+//
+//	UPDATE "quests"
+//	SET
+//	    "updated_at" = NOW(),
+//	    "version" = "version" + 1,
+//	    "name" = $3,
+//	    "description" = $4
+//	WHERE
+//	    "id" = $1 AND
+//	    "game_id" = $2 AND
+//	    "deleted_at" IS NULL
+//	RETURNING created_at, updated_at, deleted_at, id, game_id, name, description, version
+func (q *Queries) CreateQuestVersion(ctx context.Context, arg CreateQuestVersionParams) (Quest, error) {
+	row := q.db.QueryRow(ctx, createQuestVersion,
+		arg.ID,
+		arg.GameID,
+		arg.Name,
+		arg.Description,
+	)
+	var i Quest
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ID,
+		&i.GameID,
+		&i.Name,
+		&i.Description,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getQuestByIDAndGameID = `-- name: GetQuestByIDAndGameID :one
-SELECT created_at, updated_at, deleted_at, id, game_id, name, description
+SELECT created_at, updated_at, deleted_at, id, game_id, name, description, version
 FROM "quests" q
 WHERE
     q."id" = $1 AND
@@ -60,7 +159,7 @@ type GetQuestByIDAndGameIDParams struct {
 
 // GetQuestByIDAndGameID
 //
-//	SELECT created_at, updated_at, deleted_at, id, game_id, name, description
+//	SELECT created_at, updated_at, deleted_at, id, game_id, name, description, version
 //	FROM "quests" q
 //	WHERE
 //	    q."id" = $1 AND
@@ -78,10 +177,129 @@ func (q *Queries) GetQuestByIDAndGameID(ctx context.Context, arg GetQuestByIDAnd
 		&i.GameID,
 		&i.Name,
 		&i.Description,
+		&i.Version,
 	)
 	return i, err
 }
 
+const listQuestsByGameID = `-- name: ListQuestsByGameID :many
+SELECT created_at, updated_at, deleted_at, id, game_id, name, description, version
+FROM "quests" q
+WHERE
+    q."game_id" = $1 AND
+    (q."deleted_at" IS NOT NULL) = $2::BOOLEAN AND
+    q."name" ILIKE '%' || $3::VARCHAR || '%' AND
+    q."created_at" >= COALESCE($4::TIMESTAMPTZ, '-infinity') AND
+    q."created_at" < COALESCE($5::TIMESTAMPTZ, 'infinity')
+ORDER BY q."created_at" DESC, q."id" DESC
+LIMIT $6 OFFSET $7
+`
+
+type ListQuestsByGameIDParams struct {
+	GameID        string
+	Deleted       bool
+	Search        string
+	CreatedAfter  pgtype.Timestamptz
+	CreatedBefore pgtype.Timestamptz
+	Limit         int32
+	Offset        int32
+}
+
+// ListQuestsByGameID
+//
+//	SELECT created_at, updated_at, deleted_at, id, game_id, name, description, version
+//	FROM "quests" q
+//	WHERE
+//	    q."game_id" = $1 AND
+//	    (q."deleted_at" IS NOT NULL) = $2::BOOLEAN AND
+//	    q."name" ILIKE '%' || $3::VARCHAR || '%' AND
+//	    q."created_at" >= COALESCE($4::TIMESTAMPTZ, '-infinity') AND
+//	    q."created_at" < COALESCE($5::TIMESTAMPTZ, 'infinity')
+//	ORDER BY q."created_at" DESC, q."id" DESC
+//	LIMIT $6 OFFSET $7
+func (q *Queries) ListQuestsByGameID(ctx context.Context, arg ListQuestsByGameIDParams) ([]Quest, error) {
+	rows, err := q.db.Query(ctx, listQuestsByGameID,
+		arg.GameID,
+		arg.Deleted,
+		arg.Search,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Quest{}
+	for rows.Next() {
+		var i Quest
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ID,
+			&i.GameID,
+			&i.Name,
+			&i.Description,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedQuests = `-- name: PurgeDeletedQuests :exec
+DELETE FROM "quests"
+WHERE "deleted_at" < $1
+`
+
+// PurgeDeletedQuests
+//
+//	DELETE FROM "quests"
+//	WHERE "deleted_at" < $1
+func (q *Queries) PurgeDeletedQuests(ctx context.Context, deletedAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, purgeDeletedQuests, deletedAt)
+	return err
+}
+
+const restoreQuestByIDAndGameID = `-- name: RestoreQuestByIDAndGameID :execrows
+UPDATE "quests"
+SET
+    "deleted_at" = NULL
+WHERE
+    "id" = $1 AND
+    "game_id" = $2
+    AND "deleted_at" IS NOT NULL
+`
+
+type RestoreQuestByIDAndGameIDParams struct {
+	ID     uuid.UUID
+	GameID string
+}
+
+// RestoreQuestByIDAndGameID
+//
+//	UPDATE "quests"
+//	SET
+//	    "deleted_at" = NULL
+//	WHERE
+//	    "id" = $1 AND
+//	    "game_id" = $2
+//	    AND "deleted_at" IS NOT NULL
+func (q *Queries) RestoreQuestByIDAndGameID(ctx context.Context, arg RestoreQuestByIDAndGameIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreQuestByIDAndGameID, arg.ID, arg.GameID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const softDeleteQuestByIDAndGameID = `-- name: SoftDeleteQuestByIDAndGameID :execrows
 UPDATE "quests"
 SET
@@ -12,13 +12,15 @@ import (
 )
 
 const createTask = `-- name: CreateTask :one
-INSERT INTO "tasks" ("quest_id", "name", "description", "required_for_completion", "rule")
-VALUES ($1, $2, $3, $4, $5)
-RETURNING created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule
+INSERT INTO "tasks" ("quest_id", "version", "key", "name", "description", "required_for_completion", "rule")
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, version, key
 `
 
 type CreateTaskParams struct {
 	QuestID               uuid.UUID
+	Version               int32
+	Key                   string
 	Name                  string
 	Description           string
 	RequiredForCompletion bool
@@ -27,12 +29,14 @@ type CreateTaskParams struct {
 
 // CreateTask
 //
-//	INSERT INTO "tasks" ("quest_id", "name", "description", "required_for_completion", "rule")
-//	VALUES ($1, $2, $3, $4, $5)
-//	RETURNING created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule
+//	INSERT INTO "tasks" ("quest_id", "version", "key", "name", "description", "required_for_completion", "rule")
+//	VALUES ($1, $2, $3, $4, $5, $6, $7)
+//	RETURNING created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, version, key
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error) {
 	row := q.db.QueryRow(ctx, createTask,
 		arg.QuestID,
+		arg.Version,
+		arg.Key,
 		arg.Name,
 		arg.Description,
 		arg.RequiredForCompletion,
@@ -49,27 +53,88 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		&i.Description,
 		&i.RequiredForCompletion,
 		&i.Rule,
+		&i.Version,
+		&i.Key,
 	)
 	return i, err
 }
 
 const listTasksByQuestID = `-- name: ListTasksByQuestID :many
-SELECT created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, depends_on
+SELECT created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, version, key, depends_on
 FROM "tasks_with_its_dependencies" t
 WHERE
     t."quest_id" = $1 AND
+    t."version" = $2 AND
     t."deleted_at" IS NULL
 `
 
+type ListTasksByQuestIDParams struct {
+	QuestID uuid.UUID
+	Version int32
+}
+
 // ListTasksByQuestID
 //
-//	SELECT created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, depends_on
+//	SELECT created_at, updated_at, deleted_at, quest_id, id, name, description, required_for_completion, rule, version, key, depends_on
 //	FROM "tasks_with_its_dependencies" t
 //	WHERE
 //	    t."quest_id" = $1 AND
+//	    t."version" = $2 AND
 //	    t."deleted_at" IS NULL
-func (q *Queries) ListTasksByQuestID(ctx context.Context, questID uuid.UUID) ([]TasksWithItsDependency, error) {
-	rows, err := q.db.Query(ctx, listTasksByQuestID, questID)
+func (q *Queries) ListTasksByQuestID(ctx context.Context, arg ListTasksByQuestIDParams) ([]TasksWithItsDependency, error) {
+	rows, err := q.db.Query(ctx, listTasksByQuestID, arg.QuestID, arg.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TasksWithItsDependency{}
+	for rows.Next() {
+		var i TasksWithItsDependency
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.QuestID,
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.RequiredForCompletion,
+			&i.Rule,
+			&i.Version,
+			&i.Key,
+			&i.DependsOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksByQuestIDs = `-- name: ListTasksByQuestIDs :many
+SELECT t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.version, t.key, t.depends_on
+FROM "tasks_with_its_dependencies" t
+JOIN "quests" q ON q."id" = t."quest_id"
+WHERE
+    t."quest_id" = ANY($1::UUID[]) AND
+    t."version" = q."version" AND
+    t."deleted_at" IS NOT DISTINCT FROM q."deleted_at"
+`
+
+// ListTasksByQuestIDs
+//
+//	SELECT t.created_at, t.updated_at, t.deleted_at, t.quest_id, t.id, t.name, t.description, t.required_for_completion, t.rule, t.version, t.key, t.depends_on
+//	FROM "tasks_with_its_dependencies" t
+//	JOIN "quests" q ON q."id" = t."quest_id"
+//	WHERE
+//	    t."quest_id" = ANY($1::UUID[]) AND
+//	    t."version" = q."version" AND
+//	    t."deleted_at" IS NOT DISTINCT FROM q."deleted_at"
+func (q *Queries) ListTasksByQuestIDs(ctx context.Context, questIds []uuid.UUID) ([]TasksWithItsDependency, error) {
+	rows, err := q.db.Query(ctx, listTasksByQuestIDs, questIds)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +152,8 @@ func (q *Queries) ListTasksByQuestID(ctx context.Context, questID uuid.UUID) ([]
 			&i.Description,
 			&i.RequiredForCompletion,
 			&i.Rule,
+			&i.Version,
+			&i.Key,
 			&i.DependsOn,
 		); err != nil {
 			return nil, err
@@ -118,6 +185,32 @@ func (q *Queries) RegisterTaskDependency(ctx context.Context, arg RegisterTaskDe
 	return err
 }
 
+const restoreTasksByQuestID = `-- name: RestoreTasksByQuestID :exec
+UPDATE "tasks" t
+SET
+    "deleted_at" = NULL
+FROM "quests" q
+WHERE
+    t."quest_id" = $1 AND
+    q."id" = t."quest_id" AND
+    t."deleted_at" = q."deleted_at"
+`
+
+// RestoreTasksByQuestID
+//
+//	UPDATE "tasks" t
+//	SET
+//	    "deleted_at" = NULL
+//	FROM "quests" q
+//	WHERE
+//	    t."quest_id" = $1 AND
+//	    q."id" = t."quest_id" AND
+//	    t."deleted_at" = q."deleted_at"
+func (q *Queries) RestoreTasksByQuestID(ctx context.Context, questID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, restoreTasksByQuestID, questID)
+	return err
+}
+
 const softDeleteTasksByQuestID = `-- name: SoftDeleteTasksByQuestID :exec
 UPDATE "tasks"
 SET
@@ -0,0 +1,351 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/infra/storage/postgres/internal/sqlc"
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type leaderboardRewardTier struct {
+	Name         string  `json:"name"`
+	FromPosition int64   `json:"fromPosition,omitempty"`
+	ToPosition   int64   `json:"toPosition,omitempty"`
+	Percentile   float64 `json:"percentile,omitempty"`
+}
+
+type leaderboardTier struct {
+	Name       string  `json:"name"`
+	Percentile float64 `json:"percentile,omitempty"`
+	Threshold  float64 `json:"threshold,omitempty"`
+}
+
+type leaderboardSubmissionRules struct {
+	MinValue       *float64      `json:"minValue,omitempty"`
+	MaxValue       *float64      `json:"maxValue,omitempty"`
+	MaxIncrease    float64       `json:"maxIncrease,omitempty"`
+	MaxSubmissions int64         `json:"maxSubmissions,omitempty"`
+	RateWindow     time.Duration `json:"rateWindow,omitempty"`
+	RecordRejected bool          `json:"recordRejected,omitempty"`
+}
+
+func sqlcLeaderboardToDomain(l sqlc.Leaderboard) leaderboard.Leaderboard {
+	var tiers []leaderboardRewardTier
+	json.Unmarshal(l.RewardTiers, &tiers)
+
+	var placementTiers []leaderboardTier
+	json.Unmarshal(l.Tiers, &placementTiers)
+
+	var scopes []string
+	json.Unmarshal(l.Scopes, &scopes)
+
+	var rules leaderboardSubmissionRules
+	json.Unmarshal(l.SubmissionRules, &rules)
+
+	rewardTiers := make([]leaderboard.RewardTier, len(tiers))
+	for i, tier := range tiers {
+		rewardTiers[i] = leaderboard.RewardTier{
+			Name:         tier.Name,
+			FromPosition: tier.FromPosition,
+			ToPosition:   tier.ToPosition,
+			Percentile:   tier.Percentile,
+		}
+	}
+
+	domainTiers := make([]leaderboard.Tier, len(placementTiers))
+	for i, tier := range placementTiers {
+		domainTiers[i] = leaderboard.Tier{
+			Name:       tier.Name,
+			Percentile: tier.Percentile,
+			Threshold:  tier.Threshold,
+		}
+	}
+
+	return leaderboard.Leaderboard{
+		CreatedAt:       l.CreatedAt.Time,
+		UpdatedAt:       l.UpdatedAt.Time,
+		DeletedAt:       l.DeletedAt.Time,
+		ID:              l.ID.String(),
+		GameID:          l.GameID,
+		Name:            l.Name,
+		Description:     l.Description,
+		StartAt:         l.StartAt.Time,
+		EndAt:           l.EndAt.Time,
+		AggregationMode: l.AggregationMode,
+		Ordering:        l.Ordering,
+		RankingMode:     l.RankingMode,
+		TieBreakPolicy:  l.TieBreakPolicy,
+		Recurrence:      l.Recurrence,
+		Timezone:        l.Timezone,
+		RewardTiers:     rewardTiers,
+		Tiers:           domainTiers,
+		Scopes:          scopes,
+		SubmissionRules: leaderboard.SubmissionRules{
+			MinValue:       rules.MinValue,
+			MaxValue:       rules.MaxValue,
+			MaxIncrease:    rules.MaxIncrease,
+			MaxSubmissions: rules.MaxSubmissions,
+			RateWindow:     rules.RateWindow,
+			RecordRejected: rules.RecordRejected,
+		},
+		RollingWindow: time.Duration(l.RollingWindow) * time.Second,
+		NotifyTop:     l.NotifyTop,
+		StatisticID:   l.StatisticID,
+	}
+}
+
+func sqlcLeaderboardsToDomain(ls []sqlc.Leaderboard) []leaderboard.Leaderboard {
+	leaderboards := make([]leaderboard.Leaderboard, len(ls))
+	for i, l := range ls {
+		leaderboards[i] = sqlcLeaderboardToDomain(l)
+	}
+
+	return leaderboards
+}
+
+// A zero end time means that the leaderboard never closes
+func leaderboardEndAt(endAt time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: endAt.UTC(), Valid: !endAt.IsZero()}
+}
+
+func (c connection) CreateLeaderboard(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error) {
+	tiers := make([]leaderboardRewardTier, len(data.RewardTiers))
+	for i, tier := range data.RewardTiers {
+		tiers[i] = leaderboardRewardTier{
+			Name:         tier.Name,
+			FromPosition: tier.FromPosition,
+			ToPosition:   tier.ToPosition,
+			Percentile:   tier.Percentile,
+		}
+	}
+
+	placementTiers := make([]leaderboardTier, len(data.Tiers))
+	for i, tier := range data.Tiers {
+		placementTiers[i] = leaderboardTier{
+			Name:       tier.Name,
+			Percentile: tier.Percentile,
+			Threshold:  tier.Threshold,
+		}
+	}
+
+	scopes := data.Scopes
+	if scopes == nil {
+		scopes = make([]string, 0)
+	}
+
+	rewardTiers, _ := json.Marshal(tiers)
+	encodedTiers, _ := json.Marshal(placementTiers)
+	encodedScopes, _ := json.Marshal(scopes)
+	submissionRules, _ := json.Marshal(leaderboardSubmissionRules{
+		MinValue:       data.SubmissionRules.MinValue,
+		MaxValue:       data.SubmissionRules.MaxValue,
+		MaxIncrease:    data.SubmissionRules.MaxIncrease,
+		MaxSubmissions: data.SubmissionRules.MaxSubmissions,
+		RateWindow:     data.SubmissionRules.RateWindow,
+		RecordRejected: data.SubmissionRules.RecordRejected,
+	})
+
+	leaderboardData, err := c.queries.CreateLeaderboard(ctx, sqlc.CreateLeaderboardParams{
+		GameID:          data.GameID,
+		Name:            data.Name,
+		Description:     data.Description,
+		StartAt:         pgtype.Timestamptz{Time: data.StartAt, Valid: true},
+		EndAt:           leaderboardEndAt(data.EndAt),
+		AggregationMode: data.AggregationMode,
+		Ordering:        data.Ordering,
+		RankingMode:     data.RankingMode,
+		TieBreakPolicy:  data.TieBreakPolicy,
+		Recurrence:      data.Recurrence,
+		Timezone:        data.Timezone,
+		RewardTiers:     rewardTiers,
+		Tiers:           encodedTiers,
+		Scopes:          encodedScopes,
+		SubmissionRules: submissionRules,
+		RollingWindow:   int64(data.RollingWindow / time.Second),
+		NotifyTop:       data.NotifyTop,
+		StatisticID:     data.StatisticID,
+	})
+	if err != nil {
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return sqlcLeaderboardToDomain(leaderboardData), nil
+}
+
+func (c connection) GetLeaderboardByIDAndGameID(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return leaderboard.Leaderboard{}, leaderboard.ErrInvalidLeaderboardID
+	}
+
+	leaderboardData, err := c.queries.GetLeaderboardByIDAndGameID(ctx, sqlc.GetLeaderboardByIDAndGameIDParams{
+		ID:     uid,
+		GameID: gameID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = leaderboard.ErrLeaderboardNotFound
+		}
+
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return sqlcLeaderboardToDomain(leaderboardData), nil
+}
+
+func (c connection) ListLeaderboards(ctx context.Context, gameID string, filter leaderboard.LeaderboardsFilter, page, limit int64) (leaderboard.Leaderboards, error) {
+	search := searchEscaper.Replace(filter.Search)
+
+	total, err := c.queries.CountLeaderboardsByGameID(ctx, sqlc.CountLeaderboardsByGameIDParams{
+		GameID: gameID,
+		Status: filter.Status,
+		Search: search,
+	})
+	if err != nil {
+		return leaderboard.Leaderboards{}, err
+	}
+
+	leaderboardsData, err := c.queries.ListLeaderboardsByGameID(ctx, sqlc.ListLeaderboardsByGameIDParams{
+		GameID: gameID,
+		Status: filter.Status,
+		Search: search,
+		Limit:  int32(limit),
+		Offset: int32(page * limit),
+	})
+	if err != nil {
+		return leaderboard.Leaderboards{}, err
+	}
+
+	return leaderboard.Leaderboards{Total: total, Leaderboards: sqlcLeaderboardsToDomain(leaderboardsData)}, nil
+}
+
+func (c connection) ListGameLeaderboards(ctx context.Context, gameID string) ([]leaderboard.Leaderboard, error) {
+	leaderboardsData, err := c.queries.ListNotDeletedLeaderboardsByGameID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlcLeaderboardsToDomain(leaderboardsData), nil
+}
+
+func (c connection) ListLeaderboardsByStatistic(ctx context.Context, gameID, statisticID string) ([]leaderboard.Leaderboard, error) {
+	leaderboardsData, err := c.queries.ListLeaderboardsByStatistic(ctx, sqlc.ListLeaderboardsByStatisticParams{
+		GameID:      gameID,
+		StatisticID: statisticID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlcLeaderboardsToDomain(leaderboardsData), nil
+}
+
+func (c connection) GetLeaderboardsByIDs(ctx context.Context, ids []string) ([]leaderboard.Leaderboard, error) {
+	uids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		// Leaderboards with an invalid ID can't exist
+		if uid, err := uuid.Parse(id); err == nil {
+			uids = append(uids, uid)
+		}
+	}
+
+	leaderboardsData, err := c.queries.ListLeaderboardsByIDs(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlcLeaderboardsToDomain(leaderboardsData), nil
+}
+
+func (c connection) UpdateLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) (leaderboard.Leaderboard, error) {
+	uid, err := uuid.Parse(lb.ID)
+	if err != nil {
+		return leaderboard.Leaderboard{}, leaderboard.ErrInvalidLeaderboardID
+	}
+
+	leaderboardData, err := c.queries.UpdateLeaderboard(ctx, sqlc.UpdateLeaderboardParams{
+		ID:          uid,
+		Name:        lb.Name,
+		Description: lb.Description,
+		EndAt:       leaderboardEndAt(lb.EndAt),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = leaderboard.ErrLeaderboardNotFound
+		}
+
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return sqlcLeaderboardToDomain(leaderboardData), nil
+}
+
+func (c connection) SoftDeleteLeaderboard(ctx context.Context, id, gameID string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return leaderboard.ErrInvalidLeaderboardID
+	}
+
+	affected, err := c.queries.SoftDeleteLeaderboardByIDAndGameID(ctx, sqlc.SoftDeleteLeaderboardByIDAndGameIDParams{
+		ID:     uid,
+		GameID: gameID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return leaderboard.ErrLeaderboardNotFound
+	}
+
+	return nil
+}
+
+func (c connection) RestoreLeaderboard(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return leaderboard.Leaderboard{}, leaderboard.ErrInvalidLeaderboardID
+	}
+
+	leaderboardData, err := c.queries.RestoreLeaderboardByIDAndGameID(ctx, sqlc.RestoreLeaderboardByIDAndGameIDParams{
+		ID:     uid,
+		GameID: gameID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = leaderboard.ErrLeaderboardNotFound
+		}
+
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return sqlcLeaderboardToDomain(leaderboardData), nil
+}
+
+func (c connection) ListDeletedLeaderboards(ctx context.Context, deletedBefore time.Time, limit int64) ([]leaderboard.Leaderboard, error) {
+	leaderboardsData, err := c.queries.ListDeletedLeaderboards(ctx, sqlc.ListDeletedLeaderboardsParams{
+		DeletedAt: pgtype.Timestamptz{Time: deletedBefore, Valid: true},
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlcLeaderboardsToDomain(leaderboardsData), nil
+}
+
+func (c connection) PurgeLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) error {
+	uid, err := uuid.Parse(lb.ID)
+	if err != nil {
+		return leaderboard.ErrInvalidLeaderboardID
+	}
+
+	return c.queries.PurgeLeaderboard(ctx, uid)
+}
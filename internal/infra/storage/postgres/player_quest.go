@@ -27,6 +27,7 @@ func sqcStartQuestForPlayerDataToDomain(pq sqlc.PlayerQuest, q quest.Quest, ts [
 		UpdatedAt:        pq.UpdatedAt.Time,
 		PlayerID:         pq.PlayerID,
 		Quest:            q,
+		QuestVersion:     int(pq.QuestVersion),
 		CompletedAt:      pq.CompletedAt.Time,
 		TasksProgression: tasksProgression,
 	}
@@ -48,11 +49,66 @@ func sqlcGetPlayerQuestDataToDomain(pq sqlc.PlayerQuest, q quest.Quest, ts []sql
 		UpdatedAt:        pq.UpdatedAt.Time,
 		PlayerID:         pq.PlayerID,
 		Quest:            q,
+		QuestVersion:     int(pq.QuestVersion),
 		CompletedAt:      pq.CompletedAt.Time,
 		TasksProgression: tasksProgression,
 	}
 }
 
+// Starts the tasks of the player's quest version whose dependencies are completed, carrying over the progression of the
+// previous version tasks with the same key. Runs until nothing else can start, since completed tasks may unlock others
+func migratePlayerQuestTasks(ctx context.Context, queries *sqlc.Queries, pq sqlc.PlayerQuest, previousTasks []sqlc.GetPlayerQuestTasksRow, tasks []sqlc.TasksWithItsDependency) error {
+	previousTasksByKey := make(map[string]sqlc.GetPlayerQuestTasksRow)
+	for _, previousTask := range previousTasks {
+		previousTasksByKey[previousTask.TasksWithItsDependency.Key] = previousTask
+	}
+
+	var (
+		started   = make(map[uuid.UUID]bool)
+		completed = make(map[uuid.UUID]bool)
+	)
+	for startedAny := true; startedAny; {
+		startedAny = false
+		for _, task := range tasks {
+			if started[task.ID] {
+				continue
+			}
+
+			ready := true
+			for _, dependency := range task.DependsOn {
+				if !completed[dependency] {
+					ready = false
+					break
+				}
+			}
+
+			if !ready {
+				continue
+			}
+
+			params := sqlc.CreatePlayerQuestTaskParams{
+				PlayerID:      pq.PlayerID,
+				PlayerQuestID: pq.ID,
+				TaskID:        task.ID,
+			}
+			if previousTask, ok := previousTasksByKey[task.Key]; ok {
+				params.StartedAt = previousTask.StartedAt
+				params.CompletedAt = previousTask.CompletedAt
+			}
+
+			if err := queries.CreatePlayerQuestTask(ctx, params); err != nil {
+				return err
+			}
+
+			started[task.ID] = true
+			completed[task.ID] = params.CompletedAt.Valid
+			startedAny = true
+		}
+	}
+
+	return nil
+}
+
 func (c connection) StartQuestForPlayer(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
 	questID, err := uuid.Parse(q.ID)
 	if err != nil {
@@ -117,9 +173,62 @@ func (c connection) GetPlayerQuestProgression(ctx context.Context, q quest.Quest
 		return quest.PlayerQuestProgression{}, err
 	}
 
+	// Players that didn't migrate yet are still progressing on the tasks of the version they started
+	if playerQuestData.QuestVersion != int32(q.Version) {
+		tasksData, err := c.queries.ListTasksByQuestID(ctx, sqlc.ListTasksByQuestIDParams{
+			QuestID: playerQuestData.QuestID,
+			Version: playerQuestData.QuestVersion,
+		})
+		if err != nil {
+			return quest.PlayerQuestProgression{}, err
+		}
+
+		q.Version = int(playerQuestData.QuestVersion)
+		q.Tasks = make([]quest.Task, len(tasksData))
+		for i, taskData := range tasksData {
+			q.Tasks[i] = sqlcTaskWithItsDependenciesToDomain(taskData)
+		}
+	}
+
 	return sqlcGetPlayerQuestDataToDomain(playerQuestData, q, playerTasksData), nil
 }
 
+func (c connection) ListPlayerQuests(ctx context.Context, gameID, playerID string, page, limit int64) (quest.PlayerQuests, error) {
+	total, err := c.queries.CountPlayerQuestsByGameID(ctx, sqlc.CountPlayerQuestsByGameIDParams{
+		PlayerID: playerID,
+		GameID:   gameID,
+	})
+	if err != nil {
+		return quest.PlayerQuests{}, err
+	}
+
+	playerQuestsData, err := c.queries.ListPlayerQuestsByGameID(ctx, sqlc.ListPlayerQuestsByGameIDParams{
+		PlayerID: playerID,
+		GameID:   gameID,
+		Limit:    int32(limit),
+		Offset:   int32(page * limit),
+	})
+	if err != nil {
+		return quest.PlayerQuests{}, err
+	}
+
+	playerQuests := make([]quest.PlayerQuestSummary, len(playerQuestsData))
+	for i, pq := range playerQuestsData {
+		playerQuests[i] = quest.PlayerQuestSummary{
+			StartedAt:      pq.PlayerQuest.StartedAt.Time,
+			UpdatedAt:      pq.PlayerQuest.UpdatedAt.Time,
+			PlayerID:       pq.PlayerQuest.PlayerID,
+			Quest:          sqlcQuestWithTaskViewToDomain(pq.Quest, nil),
+			QuestVersion:   int(pq.PlayerQuest.QuestVersion),
+			CompletedAt:    pq.PlayerQuest.CompletedAt.Time,
+			TasksCompleted: pq.TasksCompleted,
+			TasksTotal:     pq.TasksTotal,
+		}
+	}
+
+	return quest.PlayerQuests{Total: total, Quests: playerQuests}, nil
+}
+
 func (c connection) UpdatePlayerQuestProgression(ctx context.Context, q quest.Quest, tc []string, playerID string) (quest.PlayerQuestProgression, error) {
 	questID, err := uuid.Parse(q.ID)
 	if err != nil {
@@ -174,3 +283,78 @@ func (c connection) UpdatePlayerQuestProgression(ctx context.Context, q quest.Qu
 
 	return c.GetPlayerQuestProgression(ctx, q, playerID)
 }
+
+func (c connection) MigratePlayerQuest(ctx context.Context, q quest.Quest, playerID string) (quest.PlayerQuestProgression, error) {
+	questID, err := uuid.Parse(q.ID)
+	if err != nil {
+		return quest.PlayerQuestProgression{}, quest.ErrInvalidQuestID
+	}
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+	defer tx.Rollback(context.Background())
+
+	queries := c.queries.WithTx(tx)
+
+	playerQuestData, err := queries.GetPlayerQuest(ctx, sqlc.GetPlayerQuestParams{
+		PlayerID: playerID,
+		QuestID:  questID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = quest.ErrQuestNotFound
+		}
+
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	previousTasksData, err := queries.GetPlayerQuestTasks(ctx, sqlc.GetPlayerQuestTasksParams{
+		PlayerID: playerID,
+		QuestID:  questID,
+	})
+	if err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	playerQuestData, err = queries.MigratePlayerQuest(ctx, playerQuestData.ID)
+	if err != nil {
+		// Already on the latest version
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.GetPlayerQuestProgression(ctx, q, playerID)
+		}
+
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	if err = queries.DeletePlayerQuestTasks(ctx, playerQuestData.ID); err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	tasksData, err := queries.ListTasksByQuestID(ctx, sqlc.ListTasksByQuestIDParams{
+		QuestID: questID,
+		Version: playerQuestData.QuestVersion,
+	})
+	if err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	if err = migratePlayerQuestTasks(ctx, queries, playerQuestData, previousTasksData, tasksData); err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	err = queries.MarkPlayerQuestAsCompleted(ctx, sqlc.MarkPlayerQuestAsCompletedParams{
+		QuestID:  questID,
+		PlayerID: playerID,
+	})
+	if err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return quest.PlayerQuestProgression{}, err
+	}
+
+	return c.GetPlayerQuestProgression(ctx, q, playerID)
+}
@@ -3,12 +3,15 @@ package postgres
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/gabapcia/gameblitz/internal/infra/storage/postgres/internal/sqlc"
 	"github.com/gabapcia/gameblitz/internal/quest"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func sqlcQuestWithTaskViewToDomain(q sqlc.Quest, ts []sqlc.TasksWithItsDependency) quest.Quest {
@@ -25,6 +28,7 @@ func sqlcQuestWithTaskViewToDomain(q sqlc.Quest, ts []sqlc.TasksWithItsDependenc
 		GameID:      q.GameID,
 		Name:        q.Name,
 		Description: q.Description,
+		Version:     int(q.Version),
 		Tasks:       tasks,
 	}
 }
@@ -43,6 +47,7 @@ func sqlcQuestToDomain(q sqlc.Quest, ts map[sqlc.Task][]uuid.UUID) quest.Quest {
 		GameID:      q.GameID,
 		Name:        q.Name,
 		Description: q.Description,
+		Version:     int(q.Version),
 		Tasks:       tasks,
 	}
 }
@@ -65,7 +70,44 @@ func (c connection) CreateQuest(ctx context.Context, data quest.NewQuestData) (q
 		return quest.Quest{}, err
 	}
 
-	tasksData, err := createQuestTasks(ctx, queries, questData.ID, data.Tasks)
+	tasksData, err := createQuestTasks(ctx, queries, questData.ID, questData.Version, data.Tasks)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
+	return sqlcQuestToDomain(questData, tasksData), tx.Commit(ctx)
+}
+
+func (c connection) UpdateQuest(ctx context.Context, id string, data quest.NewQuestData) (quest.Quest, error) {
+	questID, err := uuid.Parse(id)
+	if err != nil {
+		return quest.Quest{}, quest.ErrInvalidQuestID
+	}
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+	defer tx.Rollback(context.Background())
+
+	queries := c.queries.WithTx(tx)
+
+	questData, err := queries.CreateQuestVersion(ctx, sqlc.CreateQuestVersionParams{
+		ID:          questID,
+		GameID:      data.GameID,
+		Name:        data.Name,
+		Description: data.Description,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = quest.ErrQuestNotFound
+		}
+
+		return quest.Quest{}, err
+	}
+
+	// Tasks from the previous versions are kept for the players still progressing on them
+	tasksData, err := createQuestTasks(ctx, queries, questData.ID, questData.Version, data.Tasks)
 	if err != nil {
 		return quest.Quest{}, err
 	}
@@ -91,7 +133,10 @@ func (c connection) GetQuestByIDAndGameID(ctx context.Context, id, gameID string
 		return quest.Quest{}, err
 	}
 
-	tasksData, err := c.queries.ListTasksByQuestID(ctx, questData.ID)
+	tasksData, err := c.queries.ListTasksByQuestID(ctx, sqlc.ListTasksByQuestIDParams{
+		QuestID: questData.ID,
+		Version: questData.Version,
+	})
 	if err != nil {
 		return quest.Quest{}, err
 	}
@@ -99,6 +144,64 @@ func (c connection) GetQuestByIDAndGameID(ctx context.Context, id, gameID string
 	return sqlcQuestWithTaskViewToDomain(questData, tasksData), nil
 }
 
+// Escapes the LIKE wildcards so the search text is matched literally
+var searchEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (c connection) ListQuests(ctx context.Context, gameID string, filter quest.QuestsFilter, page, limit int64) (quest.Quests, error) {
+	var (
+		search        = searchEscaper.Replace(filter.Search)
+		createdAfter  = pgtype.Timestamptz{Time: filter.CreatedAfter, Valid: !filter.CreatedAfter.IsZero()}
+		createdBefore = pgtype.Timestamptz{Time: filter.CreatedBefore, Valid: !filter.CreatedBefore.IsZero()}
+	)
+
+	total, err := c.queries.CountQuestsByGameID(ctx, sqlc.CountQuestsByGameIDParams{
+		GameID:        gameID,
+		Deleted:       filter.Deleted,
+		Search:        search,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+	})
+	if err != nil {
+		return quest.Quests{}, err
+	}
+
+	questsData, err := c.queries.ListQuestsByGameID(ctx, sqlc.ListQuestsByGameIDParams{
+		GameID:        gameID,
+		Deleted:       filter.Deleted,
+		Search:        search,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Limit:         int32(limit),
+		Offset:        int32(page * limit),
+	})
+	if err != nil {
+		return quest.Quests{}, err
+	}
+
+	questIDs := make([]uuid.UUID, len(questsData))
+	for i, questData := range questsData {
+		questIDs[i] = questData.ID
+	}
+
+	// Loads the tasks of the whole page at once instead of once per quest
+	tasksData, err := c.queries.ListTasksByQuestIDs(ctx, questIDs)
+	if err != nil {
+		return quest.Quests{}, err
+	}
+
+	questTasks := make(map[uuid.UUID][]sqlc.TasksWithItsDependency)
+	for _, taskData := range tasksData {
+		questTasks[taskData.QuestID] = append(questTasks[taskData.QuestID], taskData)
+	}
+
+	quests := make([]quest.Quest, len(questsData))
+	for i, questData := range questsData {
+		quests[i] = sqlcQuestWithTaskViewToDomain(questData, questTasks[questData.ID])
+	}
+
+	return quest.Quests{Total: total, Quests: quests}, nil
+}
+
 func (c connection) SoftDeleteQuestByIDAndGameID(ctx context.Context, id, gameID string) error {
 	questID, err := uuid.Parse(id)
 	if err != nil {
@@ -131,3 +234,46 @@ func (c connection) SoftDeleteQuestByIDAndGameID(ctx context.Context, id, gameID
 
 	return tx.Commit(ctx)
 }
+
+func (c connection) RestoreQuestByIDAndGameID(ctx context.Context, id, gameID string) (quest.Quest, error) {
+	questID, err := uuid.Parse(id)
+	if err != nil {
+		return quest.Quest{}, quest.ErrInvalidQuestID
+	}
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return quest.Quest{}, err
+	}
+	defer tx.Rollback(context.Background())
+
+	queries := c.queries.WithTx(tx)
+
+	// Tasks are matched by the quest deletion time, so they must be restored while the quest is still deleted
+	if err = queries.RestoreTasksByQuestID(ctx, questID); err != nil {
+		return quest.Quest{}, err
+	}
+
+	affected, err := queries.RestoreQuestByIDAndGameID(ctx, sqlc.RestoreQuestByIDAndGameIDParams{
+		ID:     questID,
+		GameID: gameID,
+	})
+	if err != nil {
+		return quest.Quest{}, err
+	}
+
+	if affected == 0 {
+		return quest.Quest{}, quest.ErrQuestNotFound
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return quest.Quest{}, err
+	}
+
+	return c.GetQuestByIDAndGameID(ctx, id, gameID)
+}
+
+func (c connection) PurgeDeletedQuests(ctx context.Context, deletedBefore time.Time) error {
+	// Tasks and players progression are removed by the foreign keys cascade
+	return c.queries.PurgeDeletedQuests(ctx, pgtype.Timestamptz{Time: deletedBefore, Valid: true})
+}
@@ -0,0 +1,108 @@
+-- name: CreateLeaderboard :one
+INSERT INTO "leaderboards" (
+    "game_id", "name", "description", "start_at", "end_at",
+    "aggregation_mode", "ordering", "ranking_mode", "tie_break_policy", "recurrence", "timezone",
+    "reward_tiers", "tiers", "scopes", "submission_rules", "rolling_window", "notify_top", "statistic_id"
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+RETURNING *;
+
+-- name: GetLeaderboardByIDAndGameID :one
+SELECT *
+FROM "leaderboards" l
+WHERE
+    l."id" = $1 AND
+    l."game_id" = $2 AND
+    l."deleted_at" IS NULL
+LIMIT 1;
+
+-- name: ListLeaderboardsByGameID :many
+SELECT *
+FROM "leaderboards" l
+WHERE
+    l."game_id" = sqlc.arg('game_id') AND
+    CASE sqlc.arg('status')::VARCHAR
+        WHEN 'ACTIVE' THEN l."deleted_at" IS NULL AND (l."end_at" IS NULL OR l."end_at" >= NOW())
+        WHEN 'CLOSED' THEN l."deleted_at" IS NULL AND l."end_at" < NOW()
+        WHEN 'DELETED' THEN l."deleted_at" IS NOT NULL
+        ELSE l."deleted_at" IS NULL
+    END AND
+    l."name" ILIKE '%' || sqlc.arg('search')::VARCHAR || '%'
+ORDER BY l."created_at" DESC, l."id" DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountLeaderboardsByGameID :one
+SELECT COUNT(*)
+FROM "leaderboards" l
+WHERE
+    l."game_id" = sqlc.arg('game_id') AND
+    CASE sqlc.arg('status')::VARCHAR
+        WHEN 'ACTIVE' THEN l."deleted_at" IS NULL AND (l."end_at" IS NULL OR l."end_at" >= NOW())
+        WHEN 'CLOSED' THEN l."deleted_at" IS NULL AND l."end_at" < NOW()
+        WHEN 'DELETED' THEN l."deleted_at" IS NOT NULL
+        ELSE l."deleted_at" IS NULL
+    END AND
+    l."name" ILIKE '%' || sqlc.arg('search')::VARCHAR || '%';
+
+-- name: ListNotDeletedLeaderboardsByGameID :many
+SELECT *
+FROM "leaderboards" l
+WHERE
+    l."game_id" = $1 AND
+    l."deleted_at" IS NULL
+ORDER BY l."created_at" DESC;
+
+-- name: ListLeaderboardsByStatistic :many
+SELECT *
+FROM "leaderboards" l
+WHERE
+    l."game_id" = $1 AND
+    l."statistic_id" = $2;
+
+-- name: ListLeaderboardsByIDs :many
+SELECT *
+FROM "leaderboards" l
+WHERE l."id" = ANY(sqlc.arg('ids')::UUID[]);
+
+-- name: ListDeletedLeaderboards :many
+SELECT *
+FROM "leaderboards" l
+WHERE l."deleted_at" < $1
+ORDER BY l."deleted_at" ASC
+LIMIT $2;
+
+-- name: UpdateLeaderboard :one
+UPDATE "leaderboards"
+SET
+    "name" = $2,
+    "description" = $3,
+    "end_at" = $4,
+    "updated_at" = NOW()
+WHERE
+    "id" = $1 AND
+    "deleted_at" IS NULL
+RETURNING *;
+
+-- name: SoftDeleteLeaderboardByIDAndGameID :execrows
+UPDATE "leaderboards"
+SET
+    "deleted_at" = NOW()
+WHERE
+    "id" = $1 AND
+    "game_id" = $2
+    AND "deleted_at" IS NULL;
+
+-- name: RestoreLeaderboardByIDAndGameID :one
+UPDATE "leaderboards"
+SET
+    "deleted_at" = NULL,
+    "updated_at" = NOW()
+WHERE
+    "id" = $1 AND
+    "game_id" = $2
+    AND "deleted_at" IS NOT NULL
+RETURNING *;
+
+-- name: PurgeLeaderboard :exec
+DELETE FROM "leaderboards"
+WHERE "id" = $1;
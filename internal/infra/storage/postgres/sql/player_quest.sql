@@ -3,8 +3,8 @@
 ------------------------
 
 -- name: StartPlayerQuest :one
-INSERT INTO "player_quests" ("player_id", "quest_id")
-SELECT $1, q."id"
+INSERT INTO "player_quests" ("player_id", "quest_id", "quest_version")
+SELECT $1, q."id", q."version"
 FROM "quests" q
 WHERE q."id" = sqlc.arg('quest_id') AND q."deleted_at" IS NULL
 RETURNING *;
@@ -14,7 +14,7 @@ WITH "player_quest_tasks_created" AS (
     INSERT INTO "player_quest_tasks" ("player_id", "player_quest_id", "task_id")
     SELECT pq."player_id", $1, t."id"
     FROM "player_quests" pq
-    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id"
+    JOIN "tasks_with_its_dependencies" t ON t."quest_id" = pq."quest_id" AND t."version" = pq."quest_version"
     WHERE pq."id" = $1 AND ARRAY_LENGTH(t."depends_on", 1) IS NULL
     RETURNING *
 )
@@ -37,6 +37,67 @@ FROM "player_quest_tasks" pqt
 JOIN "tasks_with_its_dependencies" t ON t."id" = pqt."task_id"
 WHERE pqt."player_id" = $1 AND t."quest_id" = $2;
 
+------------------------
+-- List Player Quests --
+------------------------
+
+-- name: ListPlayerQuestsByGameID :many
+SELECT
+    sqlc.embed(pq),
+    sqlc.embed(q),
+    (
+        SELECT COUNT(*)
+        FROM "player_quest_tasks" pqt
+        WHERE pqt."player_quest_id" = pq."id" AND pqt."completed_at" IS NOT NULL
+    ) AS "tasks_completed",
+    (
+        SELECT COUNT(*)
+        FROM "tasks" t
+        WHERE t."quest_id" = q."id" AND t."version" = pq."quest_version" AND t."deleted_at" IS NULL
+    ) AS "tasks_total"
+FROM "player_quests" pq
+JOIN "quests" q ON q."id" = pq."quest_id"
+WHERE
+    pq."player_id" = sqlc.arg('player_id') AND
+    q."game_id" = sqlc.arg('game_id') AND
+    q."deleted_at" IS NULL
+ORDER BY pq."started_at" DESC, pq."id" DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountPlayerQuestsByGameID :one
+SELECT COUNT(*)
+FROM "player_quests" pq
+JOIN "quests" q ON q."id" = pq."quest_id"
+WHERE
+    pq."player_id" = $1 AND
+    q."game_id" = $2 AND
+    q."deleted_at" IS NULL;
+
+------------------------------------------------
+-- Migrate Player Quest To Its Latest Version --
+------------------------------------------------
+
+-- name: MigratePlayerQuest :one
+UPDATE "player_quests" pq
+SET
+    "updated_at" = NOW(),
+    "quest_version" = q."version"
+FROM "quests" q
+WHERE
+    q."id" = pq."quest_id" AND
+    pq."id" = $1 AND
+    pq."completed_at" IS NULL AND
+    pq."quest_version" <> q."version"
+RETURNING pq.*;
+
+-- name: DeletePlayerQuestTasks :exec
+DELETE FROM "player_quest_tasks"
+WHERE "player_quest_id" = $1;
+
+-- name: CreatePlayerQuestTask :exec
+INSERT INTO "player_quest_tasks" ("started_at", "player_id", "player_quest_id", "task_id", "completed_at")
+VALUES (COALESCE(sqlc.narg('started_at')::TIMESTAMPTZ, NOW()), sqlc.arg('player_id'), sqlc.arg('player_quest_id'), sqlc.arg('task_id'), sqlc.narg('completed_at'));
+
 ---------------------------------------
 -- Mark Quest And Tasks As Completed --
 ---------------------------------------
@@ -62,8 +123,11 @@ WITH "pq_tasks_status" AS (
 ), "pq_pending_tasks" AS (
 	SELECT t."id"
 	FROM "tasks" t
+	JOIN "player_quests" pq
+	    ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version"
 	WHERE
 	    t."quest_id" = $1 AND
+	    pq."player_id" = $2 AND
 	    t."id" NOT IN (SELECT "task_id" FROM "pq_tasks_status")
 ), "pq_tasks_ready_to_start" AS (
     SELECT td."this_task" AS "id"
@@ -89,6 +153,8 @@ WHERE
 WITH "completion_list" AS (
 	SELECT (pqt."completed_at" IS NOT NULL) AS "completed"
 	FROM "tasks" t
+	JOIN "player_quests" pq
+        ON pq."quest_id" = t."quest_id" AND pq."quest_version" = t."version" AND pq."player_id" = $2
 	LEFT JOIN "player_quest_tasks" pqt
         ON t.id = pqt."task_id" AND pqt."player_id" = $2
 	WHERE
@@ -12,6 +12,41 @@ WHERE
     q."deleted_at" IS NULL
 LIMIT 1;
 
+-- name: ListQuestsByGameID :many
+SELECT *
+FROM "quests" q
+WHERE
+    q."game_id" = sqlc.arg('game_id') AND
+    (q."deleted_at" IS NOT NULL) = sqlc.arg('deleted')::BOOLEAN AND
+    q."name" ILIKE '%' || sqlc.arg('search')::VARCHAR || '%' AND
+    q."created_at" >= COALESCE(sqlc.narg('created_after')::TIMESTAMPTZ, '-infinity') AND
+    q."created_at" < COALESCE(sqlc.narg('created_before')::TIMESTAMPTZ, 'infinity')
+ORDER BY q."created_at" DESC, q."id" DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountQuestsByGameID :one
+SELECT COUNT(*)
+FROM "quests" q
+WHERE
+    q."game_id" = sqlc.arg('game_id') AND
+    (q."deleted_at" IS NOT NULL) = sqlc.arg('deleted')::BOOLEAN AND
+    q."name" ILIKE '%' || sqlc.arg('search')::VARCHAR || '%' AND
+    q."created_at" >= COALESCE(sqlc.narg('created_after')::TIMESTAMPTZ, '-infinity') AND
+    q."created_at" < COALESCE(sqlc.narg('created_before')::TIMESTAMPTZ, 'infinity');
+
+-- name: CreateQuestVersion :one
+UPDATE "quests"
+SET
+    "updated_at" = NOW(),
+    "version" = "version" + 1,
+    "name" = $3,
+    "description" = $4
+WHERE
+    "id" = $1 AND
+    "game_id" = $2 AND
+    "deleted_at" IS NULL
+RETURNING *;
+
 -- name: SoftDeleteQuestByIDAndGameID :execrows
 UPDATE "quests"
 SET
@@ -20,3 +55,16 @@ WHERE
     "id" = $1 AND
     "game_id" = $2
     AND "deleted_at" IS NULL;
+
+-- name: RestoreQuestByIDAndGameID :execrows
+UPDATE "quests"
+SET
+    "deleted_at" = NULL
+WHERE
+    "id" = $1 AND
+    "game_id" = $2
+    AND "deleted_at" IS NOT NULL;
+
+-- name: PurgeDeletedQuests :exec
+DELETE FROM "quests"
+WHERE "deleted_at" < $1;
@@ -1,6 +1,6 @@
 -- name: CreateTask :one
-INSERT INTO "tasks" ("quest_id", "name", "description", "required_for_completion", "rule")
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO "tasks" ("quest_id", "version", "key", "name", "description", "required_for_completion", "rule")
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: RegisterTaskDependency :exec
@@ -12,8 +12,18 @@ SELECT *
 FROM "tasks_with_its_dependencies" t
 WHERE
     t."quest_id" = $1 AND
+    t."version" = $2 AND
     t."deleted_at" IS NULL;
 
+-- name: ListTasksByQuestIDs :many
+SELECT t.*
+FROM "tasks_with_its_dependencies" t
+JOIN "quests" q ON q."id" = t."quest_id"
+WHERE
+    t."quest_id" = ANY(sqlc.arg('quest_ids')::UUID[]) AND
+    t."version" = q."version" AND
+    t."deleted_at" IS NOT DISTINCT FROM q."deleted_at";
+
 -- name: SoftDeleteTasksByQuestID :exec
 UPDATE "tasks"
 SET
@@ -21,3 +31,13 @@ SET
 WHERE
     "quest_id" = $1 AND
     "deleted_at" IS NULL;
+
+-- name: RestoreTasksByQuestID :exec
+UPDATE "tasks" t
+SET
+    "deleted_at" = NULL
+FROM "quests" q
+WHERE
+    t."quest_id" = $1 AND
+    q."id" = t."quest_id" AND
+    t."deleted_at" = q."deleted_at";
@@ -20,6 +20,7 @@ func sqlcTaskWithItsDependenciesToDomain(t sqlc.TasksWithItsDependency) quest.Ta
 		UpdatedAt:             t.UpdatedAt.Time,
 		DeletedAt:             t.DeletedAt.Time,
 		ID:                    t.ID.String(),
+		Key:                   t.Key,
 		Name:                  t.Name,
 		Description:           t.Description,
 		DependsOn:             dependsOn,
@@ -39,6 +40,7 @@ func sqlcTaskToDomain(t sqlc.Task, dependsOnUUIDs []uuid.UUID) quest.Task {
 		UpdatedAt:             t.UpdatedAt.Time,
 		DeletedAt:             t.DeletedAt.Time,
 		ID:                    t.ID.String(),
+		Key:                   t.Key,
 		Name:                  t.Name,
 		Description:           t.Description,
 		DependsOn:             dependsOn,
@@ -47,7 +49,7 @@ func sqlcTaskToDomain(t sqlc.Task, dependsOnUUIDs []uuid.UUID) quest.Task {
 	}
 }
 
-func createQuestTasks(ctx context.Context, queries *sqlc.Queries, questID uuid.UUID, tasks []quest.NewTaskData) (map[sqlc.Task][]uuid.UUID, error) {
+func createQuestTasks(ctx context.Context, queries *sqlc.Queries, questID uuid.UUID, version int32, tasks []quest.NewTaskData) (map[sqlc.Task][]uuid.UUID, error) {
 	var (
 		rawDependenciesMap = make(map[uuid.UUID][]int)
 		tasksCreatedRows   = make([]sqlc.Task, len(tasks))
@@ -55,6 +57,8 @@ func createQuestTasks(ctx context.Context, queries *sqlc.Queries, questID uuid.U
 	for i, task := range tasks {
 		taskData, err := queries.CreateTask(ctx, sqlc.CreateTaskParams{
 			QuestID:               questID,
+			Version:               version,
+			Key:                   task.Key,
 			Name:                  task.Name,
 			Description:           task.Description,
 			RequiredForCompletion: task.RequiredForCompletion,
@@ -0,0 +1,109 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Bans are shared by every ranking period of the leaderboard
+func buildRankingBansKey(leaderboardID string) string {
+	return fmt.Sprintf("%s:bans", buildRankingKey(leaderboardID, "", leaderboard.Scope{}))
+}
+
+func buildRankingBansIndexKey(leaderboardID string) string {
+	return fmt.Sprintf("%s:bans:index", buildRankingKey(leaderboardID, "", leaderboard.Scope{}))
+}
+
+type Ban struct {
+	Reason   string    `json:"reason,omitempty"`
+	BannedAt time.Time `json:"bannedAt"`
+}
+
+func (c connection) BanPlayer(ctx context.Context, ban leaderboard.Ban) error {
+	data, err := json.Marshal(Ban{Reason: ban.Reason, BannedAt: ban.BannedAt})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, buildRankingBansKey(ban.LeaderboardID), ban.PlayerID, data)
+		pipe.ZAdd(ctx, buildRankingBansIndexKey(ban.LeaderboardID), redis.Z{Score: float64(ban.BannedAt.UnixMicro()), Member: ban.PlayerID})
+		return nil
+	})
+	return err
+}
+
+func (c connection) GetBans(ctx context.Context, leaderboardID string, page, limit int64) (leaderboard.Bans, error) {
+	var (
+		total     *redis.IntCmd
+		playerIDs *redis.StringSliceCmd
+		start     = page * limit
+		stop      = start + limit - 1
+	)
+
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		total = pipe.ZCard(ctx, buildRankingBansIndexKey(leaderboardID))
+		playerIDs = pipe.ZRevRange(ctx, buildRankingBansIndexKey(leaderboardID), start, stop)
+		return nil
+	})
+	if err != nil {
+		return leaderboard.Bans{}, err
+	}
+
+	bans := leaderboard.Bans{Total: total.Val(), Bans: make([]leaderboard.Ban, 0, len(playerIDs.Val()))}
+	if len(playerIDs.Val()) == 0 {
+		return bans, nil
+	}
+
+	values, err := c.rdb.HMGet(ctx, buildRankingBansKey(leaderboardID), playerIDs.Val()...).Result()
+	if err != nil {
+		return leaderboard.Bans{}, err
+	}
+
+	for i, value := range values {
+		// The player was unbanned between the commands
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var ban Ban
+		if err := json.Unmarshal([]byte(data), &ban); err != nil {
+			return leaderboard.Bans{}, err
+		}
+
+		bans.Bans = append(bans.Bans, leaderboard.Ban{
+			LeaderboardID: leaderboardID,
+			PlayerID:      playerIDs.Val()[i],
+			Reason:        ban.Reason,
+			BannedAt:      ban.BannedAt,
+		})
+	}
+
+	return bans, nil
+}
+
+func (c connection) UnbanPlayer(ctx context.Context, leaderboardID, playerID string) error {
+	var removed *redis.IntCmd
+
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		removed = pipe.HDel(ctx, buildRankingBansKey(leaderboardID), playerID)
+		pipe.ZRem(ctx, buildRankingBansIndexKey(leaderboardID), playerID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if removed.Val() == 0 {
+		return leaderboard.ErrBanNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	finalizationsKey  = "leaderboards:finalizations"
+	finalizationLease = 5 * time.Minute
+)
+
+func buildFinalizationMember(leaderboardID, period string) string {
+	return fmt.Sprintf("%s:%s", leaderboardID, period)
+}
+
+func (c connection) ScheduleFinalization(ctx context.Context, finalization leaderboard.Finalization, at time.Time) error {
+	return c.rdb.ZAdd(ctx, finalizationsKey, redis.Z{
+		Score:  float64(at.Unix()),
+		Member: buildFinalizationMember(finalization.Leaderboard.ID, finalization.Period),
+	}).Err()
+}
+
+func (c connection) ClaimDueFinalizations(ctx context.Context, until time.Time, limit int64) ([]leaderboard.Finalization, error) {
+	members, err := claimFinalizationsScript.Run(ctx, c.rdb, []string{finalizationsKey}, until.Unix(), until.Add(finalizationLease).Unix(), limit).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	finalizations := make([]leaderboard.Finalization, 0, len(members))
+	for _, member := range members {
+		leaderboardID, period, _ := strings.Cut(member, ":")
+		finalizations = append(finalizations, leaderboard.Finalization{
+			Leaderboard: leaderboard.Leaderboard{ID: leaderboardID},
+			Period:      period,
+		})
+	}
+
+	return finalizations, nil
+}
+
+func (c connection) RenewFinalization(ctx context.Context, finalization leaderboard.Finalization) error {
+	member := buildFinalizationMember(finalization.Leaderboard.ID, finalization.Period)
+
+	// Only updates the member that is still scheduled, so a completed finalization isn't brought back.
+	// The score lookup fails with `redis.Nil` when it's gone
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAddXX(ctx, finalizationsKey, redis.Z{Score: float64(time.Now().Add(finalizationLease).Unix()), Member: member})
+		pipe.ZScore(ctx, finalizationsKey, member)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return leaderboard.ErrFinalizationNotClaimed
+	}
+
+	return err
+}
+
+func (c connection) CompleteFinalization(ctx context.Context, finalization leaderboard.Finalization) error {
+	return c.rdb.ZRem(ctx, finalizationsKey, buildFinalizationMember(finalization.Leaderboard.ID, finalization.Period)).Err()
+}
@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Submissions older than that are trimmed from the player's history
+const historyMaxLength = 1000
+
+// The history is shared by every ranking period of the leaderboard
+func buildRankingPlayerHistoryKey(leaderboardID, playerID string) string {
+	return fmt.Sprintf("%s:player:%s:history", buildRankingKey(leaderboardID, "", leaderboard.Scope{}), playerID)
+}
+
+func submissionFromStream(message redis.XMessage) leaderboard.Submission {
+	var (
+		period, _ = message.Values["period"].(string)
+		value, _  = message.Values["value"].(string)
+		score, _  = message.Values["score"].(string)
+		gameID, _ = message.Values["game"].(string)
+		client, _ = message.Values["client"].(string)
+	)
+
+	submission := leaderboard.Submission{
+		ID:          message.ID,
+		Period:      period,
+		Caller:      leaderboard.Caller{GameID: gameID, Client: client},
+		SubmittedAt: streamEntryTime(message.ID),
+	}
+	submission.Value, _ = strconv.ParseFloat(value, 64)
+	submission.Score, _ = strconv.ParseFloat(score, 64)
+
+	return submission
+}
+
+func (c connection) GetPlayerHistory(ctx context.Context, leaderboardID, playerID, cursor string, limit int64) (leaderboard.History, error) {
+	messages, next, err := c.readStreamPage(ctx, buildRankingPlayerHistoryKey(leaderboardID, playerID), cursor, limit)
+	if err != nil {
+		return leaderboard.History{}, err
+	}
+
+	history := leaderboard.History{
+		Submissions: make([]leaderboard.Submission, len(messages)),
+		NextCursor:  next,
+	}
+	for i, message := range messages {
+		history.Submissions[i] = submissionFromStream(message)
+	}
+
+	return history, nil
+}
@@ -2,13 +2,49 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gabapcia/gameblitz/internal/leaderboard"
 	"github.com/google/uuid"
+
+	"github.com/redis/go-redis/v9"
 )
 
+type RewardTier struct {
+	Name         string  `json:"name"`
+	FromPosition int64   `json:"fromPosition,omitempty"`
+	ToPosition   int64   `json:"toPosition,omitempty"`
+	Percentile   float64 `json:"percentile,omitempty"`
+}
+
+type Tier struct {
+	Name       string  `json:"name"`
+	Percentile float64 `json:"percentile,omitempty"`
+	Threshold  float64 `json:"threshold,omitempty"`
+}
+
+type SubmissionRules struct {
+	MinValue       *float64      `json:"minValue,omitempty"`
+	MaxValue       *float64      `json:"maxValue,omitempty"`
+	MaxIncrease    float64       `json:"maxIncrease,omitempty"`
+	MaxSubmissions int64         `json:"maxSubmissions,omitempty"`
+	RateWindow     time.Duration `json:"rateWindow,omitempty"`
+	RecordRejected bool          `json:"recordRejected,omitempty"`
+}
+
+// A plain time.Time is a struct, which omitempty can't omit, so the optional end time is a pointer.
+// Pointers are written through their binary marshaler, so it's written as RFC 3339 text like the other times
+type endTime struct {
+	time.Time
+}
+
+func (t endTime) MarshalBinary() ([]byte, error) {
+	return t.AppendFormat(nil, time.RFC3339Nano), nil
+}
+
 type Leaderboard struct {
 	CreatedAt       time.Time  `redis:"createdAt,omitempty"`
 	UpdatedAt       time.Time  `redis:"updatedAt,omitempty"`
@@ -18,9 +54,20 @@ type Leaderboard struct {
 	Name            string     `redis:"name,omitempty"`
 	Description     string     `redis:"description,omitempty"`
 	StartAt         time.Time  `redis:"startAt,omitempty"`
-	EndAt           *time.Time `redis:"endAt,omitempty"`
+	EndAt           *endTime   `redis:"endAt,omitempty"`
 	AggregationMode string     `redis:"aggregationMode,omitempty"`
 	Ordering        string     `redis:"ordering,omitempty"`
+	RankingMode     string     `redis:"rankingMode,omitempty"`
+	TieBreakPolicy  string     `redis:"tieBreakPolicy,omitempty"`
+	Recurrence      string     `redis:"recurrence,omitempty"`
+	Timezone        string     `redis:"timezone,omitempty"`
+	RewardTiers     string     `redis:"rewardTiers,omitempty"`     // JSON encoded list of reward tiers
+	Tiers           string     `redis:"tiers,omitempty"`           // JSON encoded list of placement tiers
+	Scopes          string     `redis:"scopes,omitempty"`          // JSON encoded list of scope dimensions
+	SubmissionRules string     `redis:"submissionRules,omitempty"` // JSON encoded submission rules
+	RollingWindow   int64      `redis:"rollingWindow,omitempty"`   // Rolling window, in seconds
+	NotifyTop       int64      `redis:"notifyTop,omitempty"`
+	StatisticID     string     `redis:"statisticId,omitempty"`
 }
 
 func (l Leaderboard) toDomain() leaderboard.Leaderboard {
@@ -29,9 +76,66 @@ func (l Leaderboard) toDomain() leaderboard.Leaderboard {
 		deletedAt = *l.DeletedAt
 	}
 
+	// Leaderboards without an end time don't have the field. Ones updated to remove it for a while stored the zero time instead
 	var endAt time.Time
 	if l.EndAt != nil {
-		endAt = *l.EndAt
+		endAt = l.EndAt.Time
+	}
+
+	// Leaderboards created before the ranking modes were introduced always ranked players by their ordinal position
+	rankingMode := l.RankingMode
+	if rankingMode == "" {
+		rankingMode = leaderboard.RankingModeOrdinal
+	}
+
+	// Legacy rankings store the plain player IDs as members, so there is nothing to break ties with
+	tieBreakPolicy := l.TieBreakPolicy
+	if tieBreakPolicy == "" {
+		tieBreakPolicy = leaderboard.TieBreakPolicyNone
+	}
+
+	recurrence := l.Recurrence
+	if recurrence == "" {
+		recurrence = leaderboard.RecurrenceNone
+	}
+
+	var tiers []RewardTier
+	if l.RewardTiers != "" {
+		json.Unmarshal([]byte(l.RewardTiers), &tiers)
+	}
+
+	var placementTiers []Tier
+	if l.Tiers != "" {
+		json.Unmarshal([]byte(l.Tiers), &placementTiers)
+	}
+
+	var scopes []string
+	if l.Scopes != "" {
+		json.Unmarshal([]byte(l.Scopes), &scopes)
+	}
+
+	var rules SubmissionRules
+	if l.SubmissionRules != "" {
+		json.Unmarshal([]byte(l.SubmissionRules), &rules)
+	}
+
+	rewardTiers := make([]leaderboard.RewardTier, len(tiers))
+	for i, tier := range tiers {
+		rewardTiers[i] = leaderboard.RewardTier{
+			Name:         tier.Name,
+			FromPosition: tier.FromPosition,
+			ToPosition:   tier.ToPosition,
+			Percentile:   tier.Percentile,
+		}
+	}
+
+	domainTiers := make([]leaderboard.Tier, len(placementTiers))
+	for i, tier := range placementTiers {
+		domainTiers[i] = leaderboard.Tier{
+			Name:       tier.Name,
+			Percentile: tier.Percentile,
+			Threshold:  tier.Threshold,
+		}
 	}
 
 	return leaderboard.Leaderboard{
@@ -46,13 +150,81 @@ func (l Leaderboard) toDomain() leaderboard.Leaderboard {
 		EndAt:           endAt,
 		AggregationMode: l.AggregationMode,
 		Ordering:        l.Ordering,
+		RankingMode:     rankingMode,
+		TieBreakPolicy:  tieBreakPolicy,
+		Recurrence:      recurrence,
+		Timezone:        l.Timezone,
+		RewardTiers:     rewardTiers,
+		Tiers:           domainTiers,
+		Scopes:          scopes,
+		SubmissionRules: leaderboard.SubmissionRules{
+			MinValue:       rules.MinValue,
+			MaxValue:       rules.MaxValue,
+			MaxIncrease:    rules.MaxIncrease,
+			MaxSubmissions: rules.MaxSubmissions,
+			RateWindow:     rules.RateWindow,
+			RecordRejected: rules.RecordRejected,
+		},
+		RollingWindow: time.Duration(l.RollingWindow) * time.Second,
+		NotifyTop:     l.NotifyTop,
+		StatisticID:   l.StatisticID,
 	}
 }
 
 func newLeaderboardFromData(data leaderboard.NewLeaderboardData) Leaderboard {
-	var endAt *time.Time
+	var rewardTiers string
+	if len(data.RewardTiers) > 0 {
+		tiers := make([]RewardTier, len(data.RewardTiers))
+		for i, tier := range data.RewardTiers {
+			tiers[i] = RewardTier{
+				Name:         tier.Name,
+				FromPosition: tier.FromPosition,
+				ToPosition:   tier.ToPosition,
+				Percentile:   tier.Percentile,
+			}
+		}
+
+		encoded, _ := json.Marshal(tiers)
+		rewardTiers = string(encoded)
+	}
+
+	var tiers string
+	if len(data.Tiers) > 0 {
+		placementTiers := make([]Tier, len(data.Tiers))
+		for i, tier := range data.Tiers {
+			placementTiers[i] = Tier{
+				Name:       tier.Name,
+				Percentile: tier.Percentile,
+				Threshold:  tier.Threshold,
+			}
+		}
+
+		encoded, _ := json.Marshal(placementTiers)
+		tiers = string(encoded)
+	}
+
+	var scopes string
+	if len(data.Scopes) > 0 {
+		encoded, _ := json.Marshal(data.Scopes)
+		scopes = string(encoded)
+	}
+
+	var submissionRules string
+	if rules := data.SubmissionRules; rules != (leaderboard.SubmissionRules{}) {
+		encoded, _ := json.Marshal(SubmissionRules{
+			MinValue:       rules.MinValue,
+			MaxValue:       rules.MaxValue,
+			MaxIncrease:    rules.MaxIncrease,
+			MaxSubmissions: rules.MaxSubmissions,
+			RateWindow:     rules.RateWindow,
+			RecordRejected: rules.RecordRejected,
+		})
+		submissionRules = string(encoded)
+	}
+
+	var endAt *endTime
 	if !data.EndAt.IsZero() {
-		endAt = &data.EndAt
+		endAt = &endTime{data.EndAt.UTC()}
 	}
 
 	return Leaderboard{
@@ -66,17 +238,90 @@ func newLeaderboardFromData(data leaderboard.NewLeaderboardData) Leaderboard {
 		EndAt:           endAt,
 		AggregationMode: data.AggregationMode,
 		Ordering:        data.Ordering,
+		RankingMode:     data.RankingMode,
+		TieBreakPolicy:  data.TieBreakPolicy,
+		Recurrence:      data.Recurrence,
+		Timezone:        data.Timezone,
+		RewardTiers:     rewardTiers,
+		Tiers:           tiers,
+		Scopes:          scopes,
+		SubmissionRules: submissionRules,
+		RollingWindow:   int64(data.RollingWindow / time.Second),
+		NotifyTop:       data.NotifyTop,
+		StatisticID:     data.StatisticID,
 	}
 }
 
+const (
+	deletedLeaderboardsKey     = "leaderboards:deleted" // Soft deleted leaderboards ordered by their deletion time, waiting to be purged
+	indexedLeaderboardsKey     = "leaderboards:indexed" // Set once the leaderboards stored before the indexes were introduced are indexed
+	leaderboardPurgeScanCount  = 1000
+	leaderboardSearchChunkSize = 1000 // Number of leaderboard names loaded at once while searching an index
+	leaderboardCloseBatchSize  = 1000 // Max number of ended leaderboards moved to the closed index by each script call
+)
+
 func buildLeaderboardKey(leaderboardID string) string {
 	return fmt.Sprintf("leaderboard:%s", leaderboardID)
 }
 
+// Index of the game's leaderboards that are not deleted ordered by their creation time, so they can be listed without scanning the keyspace
+func buildGameLeaderboardsKey(gameID string) string {
+	return fmt.Sprintf("leaderboards:game:%s", gameID)
+}
+
+// Index of the game's leaderboards with the status ordered by their creation time
+func buildStatusLeaderboardsKey(gameID, status string) string {
+	return fmt.Sprintf("%s:status:%s", buildGameLeaderboardsKey(gameID), status)
+}
+
+// Active leaderboards of the game ordered by their end time, so they're moved to the closed index once it passes
+func buildEndingLeaderboardsKey(gameID string) string {
+	return fmt.Sprintf("%s:ending", buildGameLeaderboardsKey(gameID))
+}
+
+// Places the leaderboard on the indexes of its current status, removing it from the other ones
+func indexLeaderboard(ctx context.Context, pipe redis.Pipeliner, lb leaderboard.Leaderboard) {
+	var (
+		member = redis.Z{Score: float64(lb.CreatedAt.UnixMicro()), Member: lb.ID}
+		status = lb.Status()
+	)
+
+	for _, s := range leaderboard.Statuses {
+		if s != status {
+			pipe.ZRem(ctx, buildStatusLeaderboardsKey(lb.GameID, s), lb.ID)
+		}
+	}
+	pipe.ZAdd(ctx, buildStatusLeaderboardsKey(lb.GameID, status), member)
+	pipe.ZRem(ctx, buildEndingLeaderboardsKey(lb.GameID), lb.ID)
+
+	if status == leaderboard.StatusDeleted {
+		pipe.ZRem(ctx, buildGameLeaderboardsKey(lb.GameID), lb.ID)
+		return
+	}
+
+	pipe.ZAdd(ctx, buildGameLeaderboardsKey(lb.GameID), member)
+	if status == leaderboard.StatusActive && !lb.EndAt.IsZero() {
+		pipe.ZAdd(ctx, buildEndingLeaderboardsKey(lb.GameID), redis.Z{Score: float64(lb.EndAt.UnixMicro()), Member: lb.ID})
+	}
+}
+
+// Set of the game leaderboards fed by the statistic
+func buildStatisticLeaderboardsKey(gameID, statisticID string) string {
+	return fmt.Sprintf("%s:statistic:%s", buildGameLeaderboardsKey(gameID), statisticID)
+}
+
 func (c connection) CreateLeaderboard(ctx context.Context, data leaderboard.NewLeaderboardData) (leaderboard.Leaderboard, error) {
 	lb := newLeaderboardFromData(data)
 
-	if err := c.rdb.HSet(ctx, buildLeaderboardKey(lb.ID), lb).Err(); err != nil {
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, buildLeaderboardKey(lb.ID), lb)
+		indexLeaderboard(ctx, pipe, lb.toDomain())
+		if lb.StatisticID != "" {
+			pipe.SAdd(ctx, buildStatisticLeaderboardsKey(lb.GameID, lb.StatisticID), lb.ID)
+		}
+		return nil
+	})
+	if err != nil {
 		return leaderboard.Leaderboard{}, err
 	}
 
@@ -102,9 +347,428 @@ func (c connection) GetLeaderboardByIDAndGameID(ctx context.Context, id, gameID
 }
 
 func (c connection) SoftDeleteLeaderboard(ctx context.Context, id, gameID string) error {
-	if _, err := c.GetLeaderboardByIDAndGameID(ctx, id, gameID); err != nil {
+	lb, err := c.GetLeaderboardByIDAndGameID(ctx, id, gameID)
+	if err != nil {
+		return err
+	}
+
+	lb.DeletedAt = time.Now().UTC()
+
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, buildLeaderboardKey(id), "deletedAt", lb.DeletedAt)
+		pipe.ZAdd(ctx, deletedLeaderboardsKey, redis.Z{Score: float64(lb.DeletedAt.UnixMicro()), Member: id})
+		indexLeaderboard(ctx, pipe, lb)
+		return nil
+	})
+
+	return err
+}
+
+func (c connection) RestoreLeaderboard(ctx context.Context, id, gameID string) (leaderboard.Leaderboard, error) {
+	var lb Leaderboard
+	if err := c.rdb.HGetAll(ctx, buildLeaderboardKey(id)).Scan(&lb); err != nil {
+		return leaderboard.Leaderboard{}, err
+	}
+
+	if lb.ID == "" || lb.DeletedAt == nil || lb.GameID != gameID {
+		return leaderboard.Leaderboard{}, leaderboard.ErrLeaderboardNotFound
+	}
+
+	lb.DeletedAt = nil
+	lb.UpdatedAt = time.Now().UTC()
+
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, buildLeaderboardKey(id), "deletedAt")
+		pipe.HSet(ctx, buildLeaderboardKey(id), "updatedAt", lb.UpdatedAt)
+		pipe.ZRem(ctx, deletedLeaderboardsKey, id)
+		indexLeaderboard(ctx, pipe, lb.toDomain())
+		return nil
+	})
+	if err != nil {
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return lb.toDomain(), nil
+}
+
+func (c connection) ListDeletedLeaderboards(ctx context.Context, deletedBefore time.Time, limit int64) ([]leaderboard.Leaderboard, error) {
+	ids, err := c.rdb.ZRangeByScore(ctx, deletedLeaderboardsKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   fmt.Sprintf("(%d", deletedBefore.UnixMicro()),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := c.rdb.Pipeline()
+	cursors := make([]*redis.MapStringStringCmd, len(ids))
+	for i, id := range ids {
+		cursors[i] = pipe.HGetAll(ctx, buildLeaderboardKey(id))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	leaderboards := make([]leaderboard.Leaderboard, len(ids))
+	for i, cursor := range cursors {
+		var lb Leaderboard
+		if err := cursor.Scan(&lb); err != nil {
+			return nil, err
+		}
+
+		// Leaderboards whose hash is already gone still have keys to be purged
+		lb.ID = ids[i]
+		leaderboards[i] = lb.toDomain()
+	}
+
+	return leaderboards, nil
+}
+
+// Removes every key under the leaderboard prefix. Purges run on the background, so the keys are scanned instead of tracked
+func (c connection) PurgeRankings(ctx context.Context, leaderboardID string) error {
+	iter := c.rdb.Scan(ctx, 0, fmt.Sprintf("%s:*", buildLeaderboardKey(leaderboardID)), leaderboardPurgeScanCount).Iterator()
+
+	keys := make([]string, 0, leaderboardPurgeScanCount)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) < leaderboardPurgeScanCount {
+			continue
+		}
+
+		if err := c.rdb.Unlink(ctx, keys...).Err(); err != nil {
+			return err
+		}
+		keys = keys[:0]
+	}
+
+	if err := iter.Err(); err != nil {
 		return err
 	}
 
-	return c.rdb.HSetNX(ctx, buildLeaderboardKey(id), "deletedAt", time.Now().UTC()).Err()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return c.rdb.Unlink(ctx, keys...).Err()
+}
+
+func (c connection) PurgeLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) error {
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Unlink(ctx, buildLeaderboardKey(lb.ID))
+		if lb.GameID != "" {
+			pipe.ZRem(ctx, buildGameLeaderboardsKey(lb.GameID), lb.ID)
+			pipe.ZRem(ctx, buildEndingLeaderboardsKey(lb.GameID), lb.ID)
+			for _, status := range leaderboard.Statuses {
+				pipe.ZRem(ctx, buildStatusLeaderboardsKey(lb.GameID, status), lb.ID)
+			}
+			if lb.StatisticID != "" {
+				pipe.SRem(ctx, buildStatisticLeaderboardsKey(lb.GameID, lb.StatisticID), lb.ID)
+			}
+		}
+		pipe.ZRem(ctx, deletedLeaderboardsKey, lb.ID)
+		return nil
+	})
+
+	return err
+}
+
+// Indexes the leaderboards stored before the indexes were introduced, so they're listed and purged as the ones created after it.
+// The keyspace is only scanned until it succeeds once
+func (c connection) IndexLeaderboards(ctx context.Context) error {
+	indexed, err := c.rdb.Exists(ctx, indexedLeaderboardsKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if indexed > 0 {
+		return nil
+	}
+
+	iter := c.rdb.ScanType(ctx, 0, buildLeaderboardKey("*"), leaderboardPurgeScanCount, "hash").Iterator()
+
+	keys := make([]string, 0, leaderboardPurgeScanCount)
+	for iter.Next(ctx) {
+		// The rankings hashes are kept under the leaderboard prefix as well
+		if strings.Count(iter.Val(), ":") > 1 {
+			continue
+		}
+
+		keys = append(keys, iter.Val())
+		if len(keys) < leaderboardPurgeScanCount {
+			continue
+		}
+
+		if err := c.indexLeaderboardKeys(ctx, keys); err != nil {
+			return err
+		}
+		keys = keys[:0]
+	}
+
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if err := c.indexLeaderboardKeys(ctx, keys); err != nil {
+		return err
+	}
+
+	return c.rdb.Set(ctx, indexedLeaderboardsKey, time.Now().UTC(), 0).Err()
+}
+
+func (c connection) indexLeaderboardKeys(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	pipe := c.rdb.Pipeline()
+	cursors := make([]*redis.MapStringStringCmd, len(keys))
+	for i, key := range keys {
+		cursors[i] = pipe.HGetAll(ctx, key)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, cursor := range cursors {
+			var lb Leaderboard
+			if err := cursor.Scan(&lb); err != nil {
+				return err
+			}
+
+			// Purged meanwhile
+			if lb.ID == "" || lb.GameID == "" {
+				continue
+			}
+
+			if lb.DeletedAt != nil {
+				pipe.ZAdd(ctx, deletedLeaderboardsKey, redis.Z{Score: float64(lb.DeletedAt.UnixMicro()), Member: lb.ID})
+			}
+			if lb.StatisticID != "" {
+				pipe.SAdd(ctx, buildStatisticLeaderboardsKey(lb.GameID, lb.StatisticID), lb.ID)
+			}
+			indexLeaderboard(ctx, pipe, lb.toDomain())
+		}
+		return nil
+	})
+
+	return err
+}
+
+// Moves the game's leaderboards that reached their end time from the active index to the closed one
+func (c connection) closeEndedLeaderboards(ctx context.Context, gameID string) error {
+	keys := []string{
+		buildEndingLeaderboardsKey(gameID),
+		buildStatusLeaderboardsKey(gameID, leaderboard.StatusActive),
+		buildStatusLeaderboardsKey(gameID, leaderboard.StatusClosed),
+	}
+
+	for {
+		moved, err := closeLeaderboardsScript.Run(ctx, c.rdb, keys, time.Now().UnixMicro(), leaderboardCloseBatchSize).Int64()
+		if err != nil {
+			return err
+		}
+
+		if moved < leaderboardCloseBatchSize {
+			return nil
+		}
+	}
+}
+
+// Returns the ids of the index that have a name containing the search, ignoring the case, in the page requested and the total of matches.
+// The names are loaded in chunks, so the search costs a pass through the index instead of loading every leaderboard
+func (c connection) searchLeaderboards(ctx context.Context, key, search string, page, limit int64) ([]string, int64, error) {
+	var (
+		ids   = make([]string, 0, limit)
+		total int64
+		start = page * limit
+	)
+
+	search = strings.ToLower(search)
+	for offset := int64(0); ; offset += leaderboardSearchChunkSize {
+		chunk, err := c.rdb.ZRevRange(ctx, key, offset, offset+leaderboardSearchChunkSize-1).Result()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if len(chunk) == 0 {
+			return ids, total, nil
+		}
+
+		pipe := c.rdb.Pipeline()
+		cursors := make([]*redis.StringCmd, len(chunk))
+		for i, id := range chunk {
+			cursors[i] = pipe.HGet(ctx, buildLeaderboardKey(id), "name")
+		}
+
+		// Leaderboards purged in the meantime don't have a name anymore
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return nil, 0, err
+		}
+
+		for i, cursor := range cursors {
+			name, err := cursor.Result()
+			if err == redis.Nil {
+				continue
+			}
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if !strings.Contains(strings.ToLower(name), search) {
+				continue
+			}
+
+			if total >= start && total < start+limit {
+				ids = append(ids, chunk[i])
+			}
+			total++
+		}
+
+		if int64(len(chunk)) < leaderboardSearchChunkSize {
+			return ids, total, nil
+		}
+	}
+}
+
+func (c connection) ListLeaderboards(ctx context.Context, gameID string, filter leaderboard.LeaderboardsFilter, page, limit int64) (leaderboard.Leaderboards, error) {
+	key := buildGameLeaderboardsKey(gameID)
+	if filter.Status != "" {
+		key = buildStatusLeaderboardsKey(gameID, filter.Status)
+	}
+
+	if filter.Status == leaderboard.StatusActive || filter.Status == leaderboard.StatusClosed {
+		if err := c.closeEndedLeaderboards(ctx, gameID); err != nil {
+			return leaderboard.Leaderboards{}, err
+		}
+	}
+
+	var (
+		ids   []string
+		total int64
+		err   error
+	)
+	if filter.Search != "" {
+		ids, total, err = c.searchLeaderboards(ctx, key, filter.Search, page, limit)
+	} else {
+		pipe := c.rdb.Pipeline()
+		totalCursor := pipe.ZCard(ctx, key)
+		idsCursor := pipe.ZRevRange(ctx, key, page*limit, (page+1)*limit-1)
+		if _, err = pipe.Exec(ctx); err == nil {
+			ids, total = idsCursor.Val(), totalCursor.Val()
+		}
+	}
+	if err != nil {
+		return leaderboard.Leaderboards{}, err
+	}
+
+	leaderboards := make([]leaderboard.Leaderboard, 0)
+	if len(ids) > 0 {
+		if leaderboards, err = c.GetLeaderboardsByIDs(ctx, ids); err != nil {
+			return leaderboard.Leaderboards{}, err
+		}
+	}
+
+	return leaderboard.Leaderboards{Total: total, Leaderboards: leaderboards}, nil
+}
+
+func (c connection) ListGameLeaderboards(ctx context.Context, gameID string) ([]leaderboard.Leaderboard, error) {
+	ids, err := c.rdb.ZRevRange(ctx, buildGameLeaderboardsKey(gameID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetLeaderboardsByIDs(ctx, ids)
+}
+
+func (c connection) ListLeaderboardsByStatistic(ctx context.Context, gameID, statisticID string) ([]leaderboard.Leaderboard, error) {
+	ids, err := c.rdb.SMembers(ctx, buildStatisticLeaderboardsKey(gameID, statisticID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := c.rdb.Pipeline()
+	cursors := make([]*redis.MapStringStringCmd, len(ids))
+	for i, id := range ids {
+		cursors[i] = pipe.HGetAll(ctx, buildLeaderboardKey(id))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	leaderboards := make([]leaderboard.Leaderboard, 0, len(ids))
+	for _, cursor := range cursors {
+		var lb Leaderboard
+		if err := cursor.Scan(&lb); err != nil {
+			return nil, err
+		}
+
+		// The leaderboard no longer exists
+		if lb.ID == "" || lb.GameID != gameID {
+			continue
+		}
+
+		leaderboards = append(leaderboards, lb.toDomain())
+	}
+
+	return leaderboards, nil
+}
+
+func (c connection) GetLeaderboardsByIDs(ctx context.Context, ids []string) ([]leaderboard.Leaderboard, error) {
+	pipe := c.rdb.Pipeline()
+	cursors := make([]*redis.MapStringStringCmd, len(ids))
+	for i, id := range ids {
+		cursors[i] = pipe.HGetAll(ctx, buildLeaderboardKey(id))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	leaderboards := make([]leaderboard.Leaderboard, 0, len(ids))
+	for _, cursor := range cursors {
+		var lb Leaderboard
+		if err := cursor.Scan(&lb); err != nil {
+			return nil, err
+		}
+
+		// The leaderboard no longer exists
+		if lb.ID == "" {
+			continue
+		}
+
+		leaderboards = append(leaderboards, lb.toDomain())
+	}
+
+	return leaderboards, nil
+}
+
+func (c connection) UpdateLeaderboard(ctx context.Context, lb leaderboard.Leaderboard) (leaderboard.Leaderboard, error) {
+	lb.UpdatedAt = time.Now().UTC()
+
+	key := buildLeaderboardKey(lb.ID)
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "name", lb.Name, "description", lb.Description, "updatedAt", lb.UpdatedAt)
+
+		// Leaderboards without an end time don't store the field at all
+		if lb.EndAt.IsZero() {
+			pipe.HDel(ctx, key, "endAt")
+		} else {
+			pipe.HSet(ctx, key, "endAt", lb.EndAt.UTC())
+		}
+
+		// The end time decides if the leaderboard is listed as active or closed
+		indexLeaderboard(ctx, pipe, lb)
+		return nil
+	})
+	if err != nil {
+		return leaderboard.Leaderboard{}, err
+	}
+
+	return lb, nil
 }
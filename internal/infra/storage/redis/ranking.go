@@ -1,71 +1,711 @@
 package redis
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/gabapcia/gameblitz/internal/leaderboard"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
-func buildRankingKey(leaderboardID string) string {
-	return fmt.Sprintf("leaderboard:%s:ranking", leaderboardID)
+const maxTieBreakKey = 9999999999999999
+
+func buildRankingKey(leaderboardID, period string, scope leaderboard.Scope) string {
+	key := fmt.Sprintf("leaderboard:%s:ranking", leaderboardID)
+	if period != "" {
+		key = fmt.Sprintf("%s:%s", key, period)
+	}
+
+	if !scope.Global() {
+		key = fmt.Sprintf("%s:scope:%s:%s", key, scope.Dimension, scope.Value)
+	}
+
+	return key
+}
+
+func buildRankingValuesKey(rankingKey string) string {
+	return fmt.Sprintf("%s:values", rankingKey)
+}
+
+func buildRankingValuesCounterKey(rankingKey string) string {
+	return fmt.Sprintf("%s:values:counter", rankingKey)
+}
+
+func buildRankingMembersKey(rankingKey string) string {
+	return fmt.Sprintf("%s:members", rankingKey)
+}
+
+// Holds the sum and number of values of each player, used by the AVG aggregation mode
+func buildRankingAggregatesKey(rankingKey string) string {
+	return fmt.Sprintf("%s:aggregates", rankingKey)
+}
+
+// The submissions rate is shared by every ranking period of the leaderboard
+func buildRankingPlayerSubmissionsKey(leaderboardID, playerID string) string {
+	return fmt.Sprintf("%s:player:%s:submissions", buildRankingKey(leaderboardID, "", leaderboard.Scope{}), playerID)
+}
+
+func buildRankingPlayerScopesKey(rankingKey, playerID string) string {
+	return fmt.Sprintf("%s:player:%s:scopes", rankingKey, playerID)
+}
+
+func buildRankingKeysGroup(rankingKey string) []string {
+	return []string{rankingKey, buildRankingValuesKey(rankingKey), buildRankingValuesCounterKey(rankingKey), buildRankingMembersKey(rankingKey), buildRankingAggregatesKey(rankingKey)}
+}
+
+// Builds the keys of the global ranking and of every scope partition, five per ranking, as expected by the upsert and remove scripts.
+// When buckets are provided, the keys of each bucket of the rankings are built instead
+func buildRankingsKeys(leaderboardID, period string, scopes []leaderboard.Scope, buckets ...int64) []string {
+	keys := make([]string, 0, 5*(len(scopes)+1)*max(len(buckets), 1))
+	for _, scope := range append([]leaderboard.Scope{{}}, scopes...) {
+		key := buildRankingKey(leaderboardID, period, scope)
+		if len(buckets) == 0 {
+			keys = append(keys, buildRankingKeysGroup(key)...)
+			continue
+		}
+
+		for _, bucket := range buckets {
+			keys = append(keys, buildRankingKeysGroup(buildRankingBucketKey(key, bucket))...)
+		}
+	}
+
+	return keys
+}
+
+// Builds the keys and arguments of the upsert script
+func buildUpsertRankScriptParams(lb leaderboard.Leaderboard, period, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller, tieBreakKey string) ([]string, []any) {
+	keys := []string{
+		buildRankingBansKey(lb.ID),
+		buildRankingPlayerScopesKey(buildRankingKey(lb.ID, period, leaderboard.Scope{}), playerID),
+		buildRankingPlayerHistoryKey(lb.ID, playerID),
+		buildRankingPlayerSubmissionsKey(lb.ID, playerID),
+	}
+
+	// Rolling leaderboards are updated on the current bucket, which expires once it leaves the window.
+	// Their positions come from the merged buckets, so they can't be tracked on the bucket
+	var (
+		keysTTL     time.Duration
+		rankingMode = lb.RankingMode
+	)
+	if lb.Rolling() {
+		keys = append(keys, buildRankingsKeys(lb.ID, period, scopes, rollingBucketAt(time.Now()))...)
+		keysTTL = rollingBucketTTL(lb.RollingWindow)
+		rankingMode = ""
+	} else {
+		keys = append(keys, buildRankingsKeys(lb.ID, period, scopes)...)
+	}
+
+	args := []any{
+		playerID, lb.AggregationMode, value, tieBreakKey,
+		historyMaxLength, period, caller.GameID, caller.Client,
+		lb.SubmissionRules.MaxSubmissions, lb.SubmissionRules.RateWindow.Milliseconds(),
+		keysTTL.Milliseconds(), lb.Ordering, rankingMode, lb.NotifyTop,
+	}
+	for _, scope := range scopes {
+		args = append(args, fmt.Sprintf("%s:%s", scope.Dimension, scope.Value))
+	}
+
+	return keys, args
+}
+
+// Converts the errors replied by the upsert script to the domain
+func parseUpsertRankScriptError(err error) error {
+	switch {
+	case redis.HasErrorPrefix(err, "player banned"):
+		return leaderboard.ErrPlayerBanned
+	case redis.HasErrorPrefix(err, "too many submissions"):
+		return leaderboard.ErrTooManySubmissions
+	}
+
+	return err
+}
+
+// Converts the upsert script reply to the player's rank change. Only the value is replied when the positions are not tracked
+func parseUpsertRankScriptResult(lb leaderboard.Leaderboard, period, playerID string, data any) (leaderboard.RankChange, error) {
+	result, ok := data.([]any)
+	if !ok || (len(result) != 1 && (len(result) < 3 || (len(result)-3)%3 != 0)) {
+		return leaderboard.RankChange{}, fmt.Errorf("unexpected upsert rank script result: %v", data)
+	}
+
+	value, err := strconv.ParseFloat(result[0].(string), 64)
+	if err != nil {
+		return leaderboard.RankChange{}, err
+	}
+
+	change := leaderboard.RankChange{Period: period, PlayerID: playerID, Value: value}
+	if len(result) == 1 {
+		return change, nil
+	}
+
+	if previous := result[1].(int64); previous >= 0 {
+		change.PreviousPosition = &previous
+	}
+	change.Position = result[2].(int64)
+
+	displaced := result[3:]
+	change.Displaced = make([]leaderboard.Rank, len(displaced)/3)
+	for i := range change.Displaced {
+		value, err := strconv.ParseFloat(displaced[3*i+1].(string), 64)
+		if err != nil {
+			return leaderboard.RankChange{}, err
+		}
+
+		change.Displaced[i] = leaderboard.Rank{
+			LeaderboardID: lb.ID,
+			PlayerID:      displaced[3*i].(string),
+			Position:      displaced[3*i+2].(int64),
+			Value:         value,
+		}
+	}
+
+	return change, nil
+}
+
+// Builds a fixed width key that, when prefixing the ranking member, makes Redis
+// order tied players by the time they achieved their value
+func buildTieBreakKey(lb leaderboard.Leaderboard, achievedAt time.Time) string {
+	if lb.TieBreakPolicy == leaderboard.TieBreakPolicyNone {
+		return ""
+	}
+
+	// Tied members are sorted lexicographically and the DESC ordering reads them backwards
+	key := achievedAt.UnixMicro()
+	if (lb.TieBreakPolicy == leaderboard.TieBreakPolicyEarliest) == (lb.Ordering == leaderboard.OrderingDesc) {
+		key = maxTieBreakKey - key
+	}
+
+	return fmt.Sprintf("%016d", key)
+}
+
+type rankingSlice struct {
+	total    int64
+	start    int64
+	position int64
+	members  []string
+	scores   []float64
+}
+
+func parseRankingScriptResult(data any) (rankingSlice, error) {
+	result, ok := data.([]any)
+	if !ok || len(result) != 4 {
+		return rankingSlice{}, fmt.Errorf("unexpected ranking script result: %v", data)
+	}
+
+	entries, _ := result[3].([]any)
+
+	slice := rankingSlice{
+		total:    result[0].(int64),
+		start:    result[1].(int64),
+		position: result[2].(int64),
+		members:  make([]string, len(entries)/2),
+		scores:   make([]float64, len(entries)/2),
+	}
+	for i := 0; i < len(entries)/2; i++ {
+		score, err := strconv.ParseFloat(entries[2*i+1].(string), 64)
+		if err != nil {
+			return rankingSlice{}, err
+		}
+
+		slice.members[i] = entries[2*i].(string)
+		slice.scores[i] = score
+	}
+
+	return slice, nil
+}
+
+// Calculates the absolute position of every entry in the slice following the leaderboard ranking mode
+func (s rankingSlice) toDomain(lb leaderboard.Leaderboard) []leaderboard.Rank {
+	ranks := make([]leaderboard.Rank, len(s.members))
+	for i := range s.members {
+		position := s.position
+		if i > 0 {
+			previous := ranks[i-1]
+			switch {
+			case lb.RankingMode == leaderboard.RankingModeOrdinal:
+				position = s.start + int64(i)
+			case s.scores[i] == previous.Value:
+				position = previous.Position
+			case lb.RankingMode == leaderboard.RankingModeDense:
+				position = previous.Position + 1
+			default:
+				position = s.start + int64(i)
+			}
+		}
+
+		ranks[i] = leaderboard.Rank{
+			LeaderboardID: lb.ID,
+			PlayerID:      s.members[i],
+			Position:      position,
+			Value:         s.scores[i],
+		}
+	}
+
+	return ranks
+}
+
+// Orders the entries returned by the players ranking script following their ordinal rank and converts them to the domain.
+// Also returns the number of players ranked
+func parsePlayersRankingScriptResult(lb leaderboard.Leaderboard, data any) ([]leaderboard.Rank, int64, error) {
+	result, ok := data.([]any)
+	if !ok || len(result) != 2 {
+		return nil, 0, fmt.Errorf("unexpected players ranking script result: %v", data)
+	}
+
+	entries, _ := result[1].([]any)
+	if len(entries)%4 != 0 {
+		return nil, 0, fmt.Errorf("unexpected players ranking script result: %v", data)
+	}
+
+	var (
+		ranks = make([]leaderboard.Rank, len(entries)/4)
+		order = make(map[string]int64, len(ranks))
+	)
+	for i := range ranks {
+		value, err := strconv.ParseFloat(entries[4*i+1].(string), 64)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ranks[i] = leaderboard.Rank{
+			LeaderboardID: lb.ID,
+			PlayerID:      entries[4*i].(string),
+			Position:      entries[4*i+2].(int64),
+			Value:         value,
+		}
+		order[ranks[i].PlayerID] = entries[4*i+3].(int64)
+	}
+
+	slices.SortFunc(ranks, func(a, b leaderboard.Rank) int {
+		return cmp.Compare(order[a.PlayerID], order[b.PlayerID])
+	})
+
+	return ranks, result[0].(int64), nil
+}
+
+func (c connection) UpsertPlayerRankValue(ctx context.Context, lb leaderboard.Leaderboard, period, playerID string, value float64, scopes []leaderboard.Scope, caller leaderboard.Caller) (leaderboard.RankChange, error) {
+	if !slices.Contains(leaderboard.AggregationModes, lb.AggregationMode) {
+		return leaderboard.RankChange{}, leaderboard.ErrInvalidAggregationMode
+	}
+
+	keys, args := buildUpsertRankScriptParams(lb, period, playerID, value, scopes, caller, buildTieBreakKey(lb, time.Now()))
+
+	data, err := upsertRankScript.Run(ctx, c.rdb, keys, args...).Result()
+	if err != nil {
+		return leaderboard.RankChange{}, parseUpsertRankScriptError(err)
+	}
+
+	return parseUpsertRankScriptResult(lb, period, playerID, data)
+}
+
+func (c connection) UpsertPlayerRankValues(ctx context.Context, lb leaderboard.Leaderboard, period string, entries []leaderboard.RankEntry, caller leaderboard.Caller) ([]leaderboard.RankChange, []error, error) {
+	if !slices.Contains(leaderboard.AggregationModes, lb.AggregationMode) {
+		return nil, nil, leaderboard.ErrInvalidAggregationMode
+	}
+
+	var (
+		tieBreakKey = buildTieBreakKey(lb, time.Now())
+		changes     = make([]leaderboard.RankChange, len(entries))
+		errs        = make([]error, len(entries))
+		pending     = make([]int, len(entries))
+	)
+	for i := range entries {
+		pending[i] = i
+	}
+
+	// Entries are retried only when Redis doesn't have the script cached yet, which fails them without applying anything
+	for attempt := 0; attempt < 2 && len(pending) > 0; attempt++ {
+		cmds := make([]*redis.Cmd, len(pending))
+		_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, index := range pending {
+				entry := entries[index]
+				keys, args := buildUpsertRankScriptParams(lb, period, entry.PlayerID, entry.Value, entry.Scopes, caller, tieBreakKey)
+				cmds[i] = upsertRankScript.EvalSha(ctx, pipe, keys, args...)
+			}
+
+			return nil
+		})
+		// Errors replied by Redis belong to a single entry, anything else failed the whole pipeline
+		var redisErr redis.Error
+		if err != nil && !errors.As(err, &redisErr) {
+			return nil, nil, err
+		}
+
+		retry := make([]int, 0)
+		for i, index := range pending {
+			data, err := cmds[i].Result()
+			if redis.HasErrorPrefix(err, "NOSCRIPT") {
+				retry = append(retry, index)
+			}
+
+			if err == nil {
+				changes[index], err = parseUpsertRankScriptResult(lb, period, entries[index].PlayerID, data)
+			}
+
+			errs[index] = parseUpsertRankScriptError(err)
+		}
+
+		if len(retry) > 0 {
+			if err := upsertRankScript.Load(ctx, c.rdb).Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		pending = retry
+	}
+
+	return changes, errs, nil
 }
 
-func (c connection) incrementPlayerRankValue(ctx context.Context, leaderboardID, playerID string, value float64) error {
-	cursor := c.rdb.ZIncrBy(ctx, buildRankingKey(leaderboardID), value, playerID)
-	return cursor.Err()
+func (c connection) UpsertPlayerLeaderboardsRankValues(ctx context.Context, playerID string, updates []leaderboard.PlayerRankUpdate, caller leaderboard.Caller) ([]leaderboard.RankChange, []error, error) {
+	for _, update := range updates {
+		if !slices.Contains(leaderboard.AggregationModes, update.Leaderboard.AggregationMode) {
+			return nil, nil, leaderboard.ErrInvalidAggregationMode
+		}
+	}
+
+	var (
+		keys = make([]string, 0)
+		args = []any{len(updates)}
+	)
+	for _, update := range updates {
+		// Every update has its own achievement time to break ties
+		tieBreakKey := buildTieBreakKey(update.Leaderboard, time.Now())
+
+		updateKeys, updateArgs := buildUpsertRankScriptParams(update.Leaderboard, update.Period, playerID, update.Value, nil, caller, tieBreakKey)
+		keys = append(keys, updateKeys...)
+		args = append(args, len(updateKeys), len(updateArgs))
+		args = append(args, updateArgs...)
+	}
+
+	data, err := upsertRanksScript.Run(ctx, c.rdb, keys, args...).Slice()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(data) != 2 {
+		return nil, nil, fmt.Errorf("unexpected upsert ranks script result: %v", data)
+	}
+
+	replies, ok := data[1].([]any)
+	if !ok || len(replies) != len(updates) {
+		return nil, nil, fmt.Errorf("unexpected upsert ranks script result: %v", data)
+	}
+
+	// None of the updates is applied when any of them is rejected
+	if applied, _ := data[0].(int64); applied == 0 {
+		errs := make([]error, len(updates))
+		for i, reply := range replies {
+			switch reply {
+			case "player banned":
+				errs[i] = leaderboard.ErrPlayerBanned
+			case "too many submissions":
+				errs[i] = leaderboard.ErrTooManySubmissions
+			}
+		}
+
+		return nil, errs, nil
+	}
+
+	changes := make([]leaderboard.RankChange, len(updates))
+	for i, update := range updates {
+		if err, ok := replies[i].(error); ok {
+			return nil, nil, parseUpsertRankScriptError(err)
+		}
+
+		changes[i], err = parseUpsertRankScriptResult(update.Leaderboard, update.Period, playerID, replies[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return changes, make([]error, len(updates)), nil
 }
 
-func (c connection) setMaxPlayerRankValue(ctx context.Context, leaderboardID, playerID string, value float64) error {
-	cursor := c.rdb.ZAddGT(ctx, buildRankingKey(leaderboardID), redis.Z{Score: value, Member: playerID})
-	return cursor.Err()
+func (c connection) RemovePlayerRank(ctx context.Context, lb leaderboard.Leaderboard, period, playerID string) error {
+	scopesKey := buildRankingPlayerScopesKey(buildRankingKey(lb.ID, period, leaderboard.Scope{}), playerID)
+
+	members, err := c.rdb.SMembers(ctx, scopesKey).Result()
+	if err != nil {
+		return err
+	}
+
+	var (
+		scopes = make([]leaderboard.Scope, 0, len(members))
+		args   = make([]any, 0, len(members)+1)
+	)
+
+	args = append(args, playerID)
+	for _, member := range members {
+		scope, err := leaderboard.ParseScope(member)
+		if err != nil {
+			return err
+		}
+
+		scopes = append(scopes, scope)
+		args = append(args, member)
+	}
+
+	// Rolling leaderboards are removed from their buckets only. The merged rankings don't track the values shared by
+	// their players, so they're dropped instead and merged again on the next read
+	keys := []string{scopesKey}
+	if lb.Rolling() {
+		keys = append(keys, buildRankingsKeys(lb.ID, period, scopes, rollingBuckets(lb.RollingWindow, time.Now())...)...)
+	} else {
+		keys = append(keys, buildRankingsKeys(lb.ID, period, scopes)...)
+	}
+
+	removed, err := removeRankScript.Run(ctx, c.rdb, keys, args...).Int64()
+	if err != nil {
+		return err
+	}
+
+	if lb.Rolling() {
+		merged := make([]string, 0, 2*(len(scopes)+1))
+		for _, scope := range append([]leaderboard.Scope{{}}, scopes...) {
+			key := buildRankingKey(lb.ID, period, scope)
+			merged = append(merged, key, buildRankingValuesKey(key))
+		}
+
+		if err := c.rdb.Del(ctx, merged...).Err(); err != nil {
+			return err
+		}
+	}
+
+	if removed == 0 {
+		return leaderboard.ErrPlayerRankNotFound
+	}
+
+	return nil
 }
 
-func (c connection) setMinPlayerRankValue(ctx context.Context, leaderboardID, playerID string, value float64) error {
-	cursor := c.rdb.ZAddLT(ctx, buildRankingKey(leaderboardID), redis.Z{Score: value, Member: playerID})
-	return cursor.Err()
+func (c connection) GetRanking(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, page, limit int64) (leaderboard.Ranking, error) {
+	if lb.Ordering != leaderboard.OrderingAsc && lb.Ordering != leaderboard.OrderingDesc {
+		return leaderboard.Ranking{}, leaderboard.ErrInvalidOrdering
+	}
+
+	var (
+		key   = buildRankingKey(lb.ID, period, scope)
+		keys  = []string{key, buildRankingValuesKey(key), buildRankingMembersKey(key)}
+		start = page * limit
+		stop  = start + limit - 1
+	)
+
+	if err := c.mergeRankingBuckets(ctx, lb, period, scope); err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	data, err := rankingScript.Run(ctx, c.rdb, keys, lb.Ordering, lb.RankingMode, lb.TieBreakPolicy, start, stop).Result()
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	slice, err := parseRankingScriptResult(data)
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	return leaderboard.Ranking{
+		Period: period,
+		Total:  slice.total,
+		Ranks:  slice.toDomain(lb),
+	}, nil
 }
 
-func (c connection) UpsertPlayerRankValue(ctx context.Context, lb leaderboard.Leaderboard, playerID string, value float64) error {
-	switch lb.AggregationMode {
-	case leaderboard.AggregationModeInc:
-		return c.incrementPlayerRankValue(ctx, lb.ID, playerID, value)
-	case leaderboard.AggregationModeMax:
-		return c.setMaxPlayerRankValue(ctx, lb.ID, playerID, value)
-	case leaderboard.AggregationModeMin:
-		return c.setMinPlayerRankValue(ctx, lb.ID, playerID, value)
-	default:
-		return leaderboard.ErrInvalidAggregationMode
+// Rolling rankings are merged once into a copy owned by the export, since their cache may expire while the ranking is exported
+func (c connection) StreamRanking(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, chunkSize int64, write func(ranking leaderboard.Ranking) error) error {
+	if lb.Ordering != leaderboard.OrderingAsc && lb.Ordering != leaderboard.OrderingDesc {
+		return leaderboard.ErrInvalidOrdering
+	}
+
+	key := buildRankingKey(lb.ID, period, scope)
+	if lb.Rolling() {
+		key = fmt.Sprintf("%s:export:%s", key, uuid.NewString())
+		if err := c.mergeRollingBuckets(ctx, lb, period, scope, key, rollingMergeTTL); err != nil {
+			return err
+		}
+		defer c.rdb.Del(context.Background(), key, buildRankingValuesKey(key))
+	}
+
+	keys := []string{key, buildRankingValuesKey(key), buildRankingMembersKey(key)}
+	for start := int64(0); ; start += chunkSize {
+		// Keeps the copy of the rolling ranking alive while its chunks are written
+		if lb.Rolling() {
+			if _, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.PExpire(ctx, key, rollingMergeTTL)
+				pipe.PExpire(ctx, buildRankingValuesKey(key), rollingMergeTTL)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		data, err := rankingScript.Run(ctx, c.rdb, keys, lb.Ordering, lb.RankingMode, lb.TieBreakPolicy, start, start+chunkSize-1).Result()
+		if err != nil {
+			return err
+		}
+
+		slice, err := parseRankingScriptResult(data)
+		if err != nil {
+			return err
+		}
+
+		if len(slice.members) == 0 {
+			return nil
+		}
+
+		if err := write(leaderboard.Ranking{Period: period, Total: slice.total, Ranks: slice.toDomain(lb)}); err != nil {
+			return err
+		}
+
+		if int64(len(slice.members)) < chunkSize {
+			return nil
+		}
 	}
 }
 
-func (c connection) GetRanking(ctx context.Context, leaderboardID, ordering string, page, limit int64) ([]leaderboard.Rank, error) {
-	var cursor *redis.ZSliceCmd
-	switch ordering {
-	case leaderboard.OrderingAsc:
-		cursor = c.rdb.ZRangeWithScores(ctx, buildRankingKey(leaderboardID), page*limit, limit-1)
-	case leaderboard.OrderingDesc:
-		cursor = c.rdb.ZRevRangeWithScores(ctx, buildRankingKey(leaderboardID), page*limit, limit-1)
-	default:
-		return nil, leaderboard.ErrInvalidOrdering
+func (c connection) GetPlayerRank(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerID string, around int64) (leaderboard.PlayerRank, error) {
+	if lb.Ordering != leaderboard.OrderingAsc && lb.Ordering != leaderboard.OrderingDesc {
+		return leaderboard.PlayerRank{}, leaderboard.ErrInvalidOrdering
 	}
 
-	data, err := cursor.Result()
+	var (
+		key  = buildRankingKey(lb.ID, period, scope)
+		keys = []string{key, buildRankingValuesKey(key), buildRankingMembersKey(key)}
+	)
+
+	if err := c.mergeRankingBuckets(ctx, lb, period, scope); err != nil {
+		return leaderboard.PlayerRank{}, err
+	}
+
+	data, err := rankingScript.Run(ctx, c.rdb, keys, lb.Ordering, lb.RankingMode, lb.TieBreakPolicy, 0, 0, playerID, around).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			err = leaderboard.ErrPlayerRankNotFound
+		}
+
+		return leaderboard.PlayerRank{}, err
+	}
+
+	slice, err := parseRankingScriptResult(data)
 	if err != nil {
+		return leaderboard.PlayerRank{}, err
+	}
+
+	var (
+		ranks       = slice.toDomain(lb)
+		playerIndex = len(ranks)
+	)
+	for i, rank := range ranks {
+		if rank.PlayerID == playerID {
+			playerIndex = i
+			break
+		}
+	}
+
+	if playerIndex == len(ranks) {
+		return leaderboard.PlayerRank{}, leaderboard.ErrPlayerRankNotFound
+	}
+
+	return leaderboard.PlayerRank{
+		Period: period,
+		Total:  slice.total,
+		Rank:   ranks[playerIndex],
+		Above:  ranks[:playerIndex],
+		Below:  ranks[playerIndex+1:],
+	}, nil
+}
+
+func (c connection) GetPlayersRanking(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, playerIDs []string) ([]leaderboard.Rank, int64, error) {
+	if lb.Ordering != leaderboard.OrderingAsc && lb.Ordering != leaderboard.OrderingDesc {
+		return nil, 0, leaderboard.ErrInvalidOrdering
+	}
+
+	var (
+		key  = buildRankingKey(lb.ID, period, scope)
+		keys = []string{key, buildRankingValuesKey(key), buildRankingMembersKey(key)}
+		args = make([]any, 0, len(playerIDs)+3)
+	)
+
+	args = append(args, lb.Ordering, lb.RankingMode, lb.TieBreakPolicy)
+	for _, playerID := range playerIDs {
+		args = append(args, playerID)
+	}
+
+	if err := c.mergeRankingBuckets(ctx, lb, period, scope); err != nil {
+		return nil, 0, err
+	}
+
+	data, err := playersRankingScript.Run(ctx, c.rdb, keys, args...).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return parsePlayersRankingScriptResult(lb, data)
+}
+
+func (c connection) GetPlayerLeaderboardsRanks(ctx context.Context, playerID string, leaderboards []leaderboard.Leaderboard, periods []string) ([]leaderboard.LeaderboardRank, error) {
+	for _, lb := range leaderboards {
+		if lb.Ordering != leaderboard.OrderingAsc && lb.Ordering != leaderboard.OrderingDesc {
+			return nil, leaderboard.ErrInvalidOrdering
+		}
+	}
+
+	if err := c.mergeLeaderboardsRankingBuckets(ctx, leaderboards, periods); err != nil {
 		return nil, err
 	}
 
-	rankingFiltered := make([]leaderboard.Rank, len(data))
-	for i, d := range data {
-		rankingFiltered[i] = leaderboard.Rank{
-			LeaderboardID: leaderboardID,
-			PlayerID:      d.Member.(string),
-			Position:      int64(i),
-			Value:         d.Score,
+	var cmds []*redis.Cmd
+	// The ranks are only read, so the whole pipeline is sent again when Redis doesn't have the script cached yet
+	for attempt := 0; attempt < 2; attempt++ {
+		cmds = make([]*redis.Cmd, len(leaderboards))
+		_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, lb := range leaderboards {
+				key := buildRankingKey(lb.ID, periods[i], leaderboard.Scope{})
+				keys := []string{key, buildRankingValuesKey(key), buildRankingMembersKey(key)}
+				cmds[i] = playersRankingScript.EvalSha(ctx, pipe, keys, lb.Ordering, lb.RankingMode, lb.TieBreakPolicy, playerID)
+			}
+
+			return nil
+		})
+		if err == nil {
+			break
+		}
+
+		if !redis.HasErrorPrefix(err, "NOSCRIPT") || attempt > 0 {
+			return nil, err
+		}
+
+		if err := playersRankingScript.Load(ctx, c.rdb).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	ranks := make([]leaderboard.LeaderboardRank, 0, len(leaderboards))
+	for i, lb := range leaderboards {
+		entries, total, err := parsePlayersRankingScriptResult(lb, cmds[i].Val())
+		if err != nil {
+			return nil, err
 		}
+
+		// The leaderboard doesn't rank the player
+		if len(entries) == 0 {
+			continue
+		}
+
+		ranks = append(ranks, leaderboard.LeaderboardRank{
+			Leaderboard: lb,
+			Period:      periods[i],
+			Total:       total,
+			Rank:        entries[0],
+		})
 	}
 
-	return rankingFiltered, nil
+	return ranks, nil
 }
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Rejections older than that are trimmed from the review stream
+const rejectionsMaxLength = 10000
+
+func buildRankingRejectionsKey(leaderboardID string) string {
+	return fmt.Sprintf("%s:rejections", buildRankingKey(leaderboardID, "", leaderboard.Scope{}))
+}
+
+func rejectionFromStream(message redis.XMessage) leaderboard.Rejection {
+	var (
+		playerID, _ = message.Values["player"].(string)
+		period, _   = message.Values["period"].(string)
+		value, _    = message.Values["value"].(string)
+		reason, _   = message.Values["reason"].(string)
+		gameID, _   = message.Values["game"].(string)
+		client, _   = message.Values["client"].(string)
+	)
+
+	rejection := leaderboard.Rejection{
+		ID:         message.ID,
+		PlayerID:   playerID,
+		Period:     period,
+		Reason:     reason,
+		Caller:     leaderboard.Caller{GameID: gameID, Client: client},
+		RejectedAt: streamEntryTime(message.ID),
+	}
+	rejection.Value, _ = strconv.ParseFloat(value, 64)
+
+	return rejection
+}
+
+func (c connection) RecordRejections(ctx context.Context, leaderboardID string, rejections []leaderboard.Rejection) error {
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, rejection := range rejections {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: buildRankingRejectionsKey(leaderboardID),
+				MaxLen: rejectionsMaxLength,
+				Approx: true,
+				Values: []any{
+					"player", rejection.PlayerID,
+					"period", rejection.Period,
+					"value", rejection.Value,
+					"reason", rejection.Reason,
+					"game", rejection.GameID,
+					"client", rejection.Client,
+				},
+			})
+		}
+
+		return nil
+	})
+	return err
+}
+
+func (c connection) GetRejections(ctx context.Context, leaderboardID, cursor string, limit int64) (leaderboard.Rejections, error) {
+	messages, next, err := c.readStreamPage(ctx, buildRankingRejectionsKey(leaderboardID), cursor, limit)
+	if err != nil {
+		return leaderboard.Rejections{}, err
+	}
+
+	rejections := leaderboard.Rejections{
+		Rejections: make([]leaderboard.Rejection, len(messages)),
+		NextCursor: next,
+	}
+	for i, message := range messages {
+		rejections.Rejections[i] = rejectionFromStream(message)
+	}
+
+	return rejections, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	//go:embed scripts/upsert_rank.lua
+	upsertRankScriptSource string
+	upsertRankScript       = redis.NewScript(upsertRankScriptSource)
+
+	//go:embed scripts/upsert_ranks.lua
+	upsertRanksScriptSource string
+	// The upsert rank script is included as a function, so both scripts apply each update the same way
+	upsertRanksScript = redis.NewScript(strings.Replace(upsertRanksScriptSource, "-- {{upsert_rank}}", upsertRankScriptSource, 1))
+
+	//go:embed scripts/remove_rank.lua
+	removeRankScriptSource string
+	removeRankScript       = redis.NewScript(removeRankScriptSource)
+
+	//go:embed scripts/ranking.lua
+	rankingScriptSource string
+	rankingScript       = redis.NewScript(rankingScriptSource)
+
+	//go:embed scripts/players_ranking.lua
+	playersRankingScriptSource string
+	playersRankingScript       = redis.NewScript(playersRankingScriptSource)
+
+	//go:embed scripts/claim_merge.lua
+	claimMergeScriptSource string
+	claimMergeScript       = redis.NewScript(claimMergeScriptSource)
+
+	//go:embed scripts/claim_finalizations.lua
+	claimFinalizationsScriptSource string
+	claimFinalizationsScript       = redis.NewScript(claimFinalizationsScriptSource)
+
+	//go:embed scripts/close_leaderboards.lua
+	closeLeaderboardsScriptSource string
+	closeLeaderboardsScript       = redis.NewScript(closeLeaderboardsScriptSource)
+)
@@ -0,0 +1,19 @@
+-- Claims the finalizations scheduled until the time provided, postponing them
+-- until the lease expires so other workers skip them while they're processed.
+--
+-- KEYS[1] finalizations sorted set
+--
+-- ARGV[1] claim time (unix seconds)
+-- ARGV[2] lease expiration time (unix seconds)
+-- ARGV[3] max number of finalizations to claim
+--
+-- Returns the finalizations claimed
+
+local finalizations = KEYS[1]
+
+local members = redis.call('ZRANGEBYSCORE', finalizations, '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, member in ipairs(members) do
+    redis.call('ZADD', finalizations, ARGV[2], member)
+end
+
+return members
@@ -0,0 +1,32 @@
+-- Decides if the caller must merge the buckets of a rolling window into the
+-- ranking cache. Only one caller merges at a time: the stale cache has its TTL
+-- extended while it's rebuilt, so the others keep reading it meanwhile.
+--
+-- KEYS[1] ranking sorted set
+-- KEYS[2] distinct values sorted set
+-- KEYS[3] merge lock
+--
+-- ARGV[1] cache TTL, in milliseconds
+-- ARGV[2] minimum cache TTL, in milliseconds
+-- ARGV[3] merge lock TTL, in milliseconds
+--
+-- Returns 1 when the caller must merge the buckets, 0 otherwise
+
+local ranking, values, lock = KEYS[1], KEYS[2], KEYS[3]
+
+if redis.call('PTTL', ranking) > tonumber(ARGV[2]) then
+    return 0
+end
+
+if redis.call('SET', lock, '1', 'NX', 'PX', ARGV[3]) then
+    redis.call('PEXPIRE', ranking, ARGV[1])
+    redis.call('PEXPIRE', values, ARGV[1])
+    return 1
+end
+
+-- Another caller is merging. Nothing is cached yet, so there's nothing to read meanwhile
+if redis.call('EXISTS', ranking) == 0 then
+    return 1
+end
+
+return 0
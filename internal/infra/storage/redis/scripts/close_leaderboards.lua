@@ -0,0 +1,25 @@
+-- Moves the leaderboards whose end time already passed from the active index
+-- of the game to the closed one, keeping their creation time as the score.
+--
+-- KEYS[1] end times sorted set of the game active leaderboards
+-- KEYS[2] active leaderboards sorted set
+-- KEYS[3] closed leaderboards sorted set
+--
+-- ARGV[1] current time (unix microseconds)
+-- ARGV[2] max number of leaderboards to move
+--
+-- Returns the number of leaderboards moved
+
+local ends, active, closed = KEYS[1], KEYS[2], KEYS[3]
+
+local ids = redis.call('ZRANGEBYSCORE', ends, '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, id in ipairs(ids) do
+    local createdAt = redis.call('ZSCORE', active, id)
+    if createdAt then
+        redis.call('ZREM', active, id)
+        redis.call('ZADD', closed, createdAt, id)
+    end
+    redis.call('ZREM', ends, id)
+end
+
+return #ids
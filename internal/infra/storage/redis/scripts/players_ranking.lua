@@ -0,0 +1,78 @@
+-- Returns the rank of each player provided, skipping the ones that are not
+-- ranked, with the data needed to order them and to calculate their absolute
+-- position, all in a single round trip.
+--
+-- KEYS[1] ranking sorted set
+-- KEYS[2] distinct values sorted set
+-- KEYS[3] player id to ranking member hash
+--
+-- ARGV[1] ordering
+-- ARGV[2] ranking mode
+-- ARGV[3] tie break policy
+-- ARGV[4...] player ids
+--
+-- Returns {total, {player id, score, position, ordinal rank, ...}}
+
+local ranking, values, members = KEYS[1], KEYS[2], KEYS[3]
+local ordering, mode, tiebreak = ARGV[1], ARGV[2], ARGV[3]
+local players = { unpack(ARGV, 4) }
+
+local function count_better(key, score)
+    if ordering == 'DESC' then
+        return redis.call('ZCOUNT', key, '(' .. score, '+inf')
+    end
+
+    return redis.call('ZCOUNT', key, '-inf', '(' .. score)
+end
+
+local total = redis.call('ZCARD', ranking)
+
+local entries = {}
+if #players == 0 then
+    return {total, entries}
+end
+
+local keys = players
+if tiebreak ~= 'NONE' then
+    keys = redis.call('HMGET', members, unpack(players))
+end
+
+local member_keys, indexes = {}, {}
+for i, key in ipairs(keys) do
+    if key then
+        member_keys[#member_keys + 1] = key
+        indexes[#indexes + 1] = i
+    end
+end
+
+if #member_keys == 0 then
+    return {total, entries}
+end
+
+local scores = redis.call('ZMSCORE', ranking, unpack(member_keys))
+for i, score in ipairs(scores) do
+    if score then
+        local member = member_keys[i]
+
+        local rank
+        if ordering == 'DESC' then
+            rank = redis.call('ZREVRANK', ranking, member)
+        else
+            rank = redis.call('ZRANK', ranking, member)
+        end
+
+        local position = rank
+        if mode == 'STANDARD' then
+            position = count_better(ranking, score)
+        elseif mode == 'DENSE' then
+            position = count_better(values, score)
+        end
+
+        entries[#entries + 1] = players[indexes[i]]
+        entries[#entries + 1] = score
+        entries[#entries + 1] = position
+        entries[#entries + 1] = rank
+    end
+end
+
+return {total, entries}
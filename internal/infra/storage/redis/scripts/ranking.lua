@@ -0,0 +1,81 @@
+-- Returns a slice of the ranking with the data needed to calculate the
+-- absolute position of its entries.
+-- When a player id is provided the slice is centered on the player.
+--
+-- KEYS[1] ranking sorted set
+-- KEYS[2] distinct values sorted set
+-- KEYS[3] player id to ranking member hash
+--
+-- ARGV[1] ordering
+-- ARGV[2] ranking mode
+-- ARGV[3] tie break policy
+-- ARGV[4] slice start (ignored when a player id is provided)
+-- ARGV[5] slice stop (ignored when a player id is provided)
+-- ARGV[6] player id (optional)
+-- ARGV[7] number of entries to return above and below the player
+--
+-- Returns {total, start, first entry position, {player id, score, ...}}
+
+local ranking, values, members = KEYS[1], KEYS[2], KEYS[3]
+local ordering, mode, tiebreak = ARGV[1], ARGV[2], ARGV[3]
+local start, stop = tonumber(ARGV[4]), tonumber(ARGV[5])
+local encoded = tiebreak ~= 'NONE'
+
+local function count_better(key, score)
+    if ordering == 'DESC' then
+        return redis.call('ZCOUNT', key, '(' .. score, '+inf')
+    end
+
+    return redis.call('ZCOUNT', key, '-inf', '(' .. score)
+end
+
+if ARGV[6] then
+    local member = ARGV[6]
+    if encoded then
+        member = redis.call('HGET', members, member)
+        if not member then
+            return nil
+        end
+    end
+
+    local rank
+    if ordering == 'DESC' then
+        rank = redis.call('ZREVRANK', ranking, member)
+    else
+        rank = redis.call('ZRANK', ranking, member)
+    end
+
+    if not rank then
+        return nil
+    end
+
+    local around = tonumber(ARGV[7])
+    start = math.max(rank - around, 0)
+    stop = rank + around
+end
+
+local entries
+if ordering == 'DESC' then
+    entries = redis.call('ZREVRANGE', ranking, start, stop, 'WITHSCORES')
+else
+    entries = redis.call('ZRANGE', ranking, start, stop, 'WITHSCORES')
+end
+
+if encoded then
+    for i = 1, #entries, 2 do
+        entries[i] = string.sub(entries[i], string.find(entries[i], '|', 1, true) + 1)
+    end
+end
+
+local total = redis.call('ZCARD', ranking)
+
+local position = start
+if #entries > 0 then
+    if mode == 'STANDARD' then
+        position = count_better(ranking, entries[2])
+    elseif mode == 'DENSE' then
+        position = count_better(values, entries[2])
+    end
+end
+
+return {total, start, position, entries}
@@ -0,0 +1,55 @@
+-- Removes the player's entry from the global ranking and from its scope
+-- partitions, keeping the index of distinct ranking values (used by the DENSE
+-- ranking mode) updated.
+--
+-- Only the scope partitions provided are forgotten, so a partition tracked
+-- after they were read is still removed the next time.
+--
+-- After the first key, the keys come in groups of five, one for each ranking
+-- (the global ranking first, followed by its scope partitions). Rolling
+-- leaderboards provide the keys of every bucket of the window instead.
+--
+-- KEYS[1] player's scope partitions set
+-- KEYS[n] ranking sorted set
+-- KEYS[n+1] distinct values sorted set
+-- KEYS[n+2] distinct values reference counter hash
+-- KEYS[n+3] player id to ranking member hash
+-- KEYS[n+4] players sum and number of values hash
+--
+-- ARGV[1] player id
+-- ARGV[2...] scope partitions ("{dimension}:{value}"), following the key groups order
+--
+-- Returns the number of rankings the player was removed from
+
+local player = ARGV[1]
+
+local function remove(ranking, values, counter, members, aggregates)
+    local member = redis.call('HGET', members, player) or player
+
+    local score = redis.call('ZSCORE', ranking, member)
+    if not score then
+        return 0
+    end
+
+    redis.call('ZREM', ranking, member)
+    redis.call('HDEL', members, player)
+    redis.call('HDEL', aggregates, player .. ':sum', player .. ':count')
+
+    if redis.call('HINCRBY', counter, score, -1) <= 0 then
+        redis.call('HDEL', counter, score)
+        redis.call('ZREM', values, score)
+    end
+
+    return 1
+end
+
+local removed = 0
+for i = 2, #KEYS, 5 do
+    removed = removed + remove(KEYS[i], KEYS[i + 1], KEYS[i + 2], KEYS[i + 3], KEYS[i + 4])
+end
+
+for i = 2, #ARGV do
+    redis.call('SREM', KEYS[1], ARGV[i])
+end
+
+return removed
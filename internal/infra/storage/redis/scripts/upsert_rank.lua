@@ -0,0 +1,252 @@
+-- Applies the leaderboard aggregation mode to the player's rank value and keeps
+-- the index of distinct ranking values (used by the DENSE ranking mode) updated.
+--
+-- When a tie break key is provided the ranking member is stored as
+-- "{tie break key}|{player id}" so tied players are ordered by the key. The
+-- key is refreshed every time the player's value changes.
+--
+-- Banned players, and players that already made the maximum number of
+-- submissions on the current rate window, are rejected before anything
+-- changes. The scope partitions the player is ranked on are tracked so its
+-- entry can be removed later, and every submission is appended to the
+-- player's history with its resulting value on the first ranking.
+--
+-- When the ranking mode is provided, the player's position on the first
+-- ranking is captured before and after the update. If a top size is also
+-- provided, the players that were on the top positions before the update and
+-- aren't anymore are returned as displaced.
+--
+-- After the first four keys, the keys come in groups of five, one for each
+-- ranking that must be updated (the global ranking first, followed by its
+-- scope partitions), so all of them change at once. Rolling leaderboards
+-- provide the keys of the current bucket of each ranking, which expire once
+-- they leave the window.
+--
+-- KEYS[1] banned players hash
+-- KEYS[2] player's scope partitions set
+-- KEYS[3] player's submissions history stream
+-- KEYS[4] player's submissions counter on the current rate window
+-- KEYS[n] ranking sorted set
+-- KEYS[n+1] distinct values sorted set
+-- KEYS[n+2] distinct values reference counter hash
+-- KEYS[n+3] player id to ranking member hash
+-- KEYS[n+4] players sum and number of values hash (used by the AVG aggregation mode)
+--
+-- ARGV[1] player id
+-- ARGV[2] aggregation mode
+-- ARGV[3] value
+-- ARGV[4] tie break key (empty when ties are not broken)
+-- ARGV[5] maximum number of submissions kept on the history (approximate)
+-- ARGV[6] ranking period ID
+-- ARGV[7] caller game ID
+-- ARGV[8] caller client
+-- ARGV[9] maximum number of submissions per rate window (0 when there's no limit)
+-- ARGV[10] rate window, in milliseconds
+-- ARGV[11] rankings TTL, in milliseconds (0 when the rankings never expire)
+-- ARGV[12] ordering
+-- ARGV[13] ranking mode (empty when the positions are not tracked)
+-- ARGV[14] size of the top whose displaced players are returned (0 when they're not)
+-- ARGV[15...] scope partitions ("{dimension}:{value}"), following the key groups order
+--
+-- Returns {player's value, previous position (-1 when it wasn't ranked), position, displaced player id, value, position, ...}
+-- on the first ranking. Only the player's value is returned when the positions are not tracked
+
+local player, mode, value, tiebreak = ARGV[1], ARGV[2], ARGV[3], ARGV[4]
+
+local modes = { INC = true, MAX = true, MIN = true, LAST = true, AVG = true, COUNT = true }
+if not modes[mode] then
+    return redis.error_reply('invalid aggregation mode')
+end
+
+if redis.call('HEXISTS', KEYS[1], player) == 1 then
+    return redis.error_reply('player banned')
+end
+
+local limit = tonumber(ARGV[9])
+if limit > 0 then
+    local submissions = redis.call('INCR', KEYS[4])
+    if submissions == 1 then
+        redis.call('PEXPIRE', KEYS[4], ARGV[10])
+    end
+
+    if submissions > limit then
+        return redis.error_reply('too many submissions')
+    end
+end
+
+local ordering, ranking_mode, top = ARGV[12], ARGV[13], tonumber(ARGV[14])
+
+local function member_of(members, id)
+    if tiebreak ~= '' then
+        return redis.call('HGET', members, id)
+    end
+
+    return id
+end
+
+local function player_of(member)
+    if tiebreak ~= '' then
+        return member:sub(#tiebreak + 2)
+    end
+
+    return member
+end
+
+local function count_better(key, score)
+    if ordering == 'DESC' then
+        return redis.call('ZCOUNT', key, '(' .. score, '+inf')
+    end
+
+    return redis.call('ZCOUNT', key, '-inf', '(' .. score)
+end
+
+local function position(ranking, values, member, score)
+    if ranking_mode == 'STANDARD' then
+        return count_better(ranking, score)
+    elseif ranking_mode == 'DENSE' then
+        return count_better(values, score)
+    elseif ordering == 'DESC' then
+        return redis.call('ZREVRANK', ranking, member)
+    end
+
+    return redis.call('ZRANK', ranking, member)
+end
+
+-- Members on the top positions, in the ranking order. Tied members share
+-- their position, so the top may have more members than positions
+local function top_members(ranking, values)
+    if ranking_mode == 'ORDINAL' then
+        if ordering == 'DESC' then
+            return redis.call('ZREVRANGE', ranking, 0, top - 1)
+        end
+
+        return redis.call('ZRANGE', ranking, 0, top - 1)
+    end
+
+    local source = ranking
+    if ranking_mode == 'DENSE' then
+        source = values
+    end
+
+    if ordering == 'DESC' then
+        local last = redis.call('ZREVRANGE', source, top - 1, top - 1, 'WITHSCORES')
+        return redis.call('ZREVRANGEBYSCORE', ranking, '+inf', last[2] or '-inf')
+    end
+
+    local last = redis.call('ZRANGE', source, top - 1, top - 1, 'WITHSCORES')
+    return redis.call('ZRANGEBYSCORE', ranking, '-inf', last[2] or '+inf')
+end
+
+local function upsert(ranking, values, counter, members, aggregates)
+    local member = player
+    if tiebreak ~= '' then
+        member = redis.call('HGET', members, player) or (tiebreak .. '|' .. player)
+    end
+
+    local previous = redis.call('ZSCORE', ranking, member)
+
+    if mode == 'INC' then
+        redis.call('ZINCRBY', ranking, value, member)
+    elseif mode == 'MAX' then
+        redis.call('ZADD', ranking, 'GT', value, member)
+    elseif mode == 'MIN' then
+        redis.call('ZADD', ranking, 'LT', value, member)
+    elseif mode == 'LAST' then
+        redis.call('ZADD', ranking, value, member)
+    elseif mode == 'COUNT' then
+        redis.call('ZINCRBY', ranking, 1, member)
+    else
+        local sum = redis.call('HINCRBYFLOAT', aggregates, player .. ':sum', value)
+        local count = redis.call('HINCRBY', aggregates, player .. ':count', 1)
+        redis.call('ZADD', ranking, tonumber(sum) / count, member)
+    end
+
+    local current = redis.call('ZSCORE', ranking, member)
+    if previous == current then
+        return current
+    end
+
+    if tiebreak ~= '' then
+        if previous then
+            redis.call('ZREM', ranking, member)
+            member = tiebreak .. '|' .. player
+            redis.call('ZADD', ranking, current, member)
+        end
+
+        redis.call('HSET', members, player, member)
+    end
+
+    if previous then
+        if redis.call('HINCRBY', counter, previous, -1) <= 0 then
+            redis.call('HDEL', counter, previous)
+            redis.call('ZREM', values, previous)
+        end
+    end
+
+    if redis.call('HINCRBY', counter, current, 1) == 1 then
+        redis.call('ZADD', values, current, current)
+    end
+
+    return current
+end
+
+local ttl = tonumber(ARGV[11])
+local tracked = ranking_mode ~= ''
+
+local previous_position, previous_top = -1, {}
+if tracked then
+    local member = member_of(KEYS[8], player)
+    local score = member and redis.call('ZSCORE', KEYS[5], member)
+    if score then
+        previous_position = position(KEYS[5], KEYS[6], member, score)
+    end
+
+    if top > 0 then
+        previous_top = top_members(KEYS[5], KEYS[6])
+    end
+end
+
+local result
+for i = 5, #KEYS, 5 do
+    local current = upsert(KEYS[i], KEYS[i + 1], KEYS[i + 2], KEYS[i + 3], KEYS[i + 4])
+    if i == 5 then
+        result = current
+    end
+
+    if ttl > 0 then
+        for j = i, i + 4 do
+            redis.call('PEXPIRE', KEYS[j], ttl)
+        end
+    end
+end
+
+for i = 15, #ARGV do
+    redis.call('SADD', KEYS[2], ARGV[i])
+end
+
+redis.call('XADD', KEYS[3], 'MAXLEN', '~', ARGV[5], '*',
+    'period', ARGV[6], 'value', value, 'score', result, 'game', ARGV[7], 'client', ARGV[8])
+
+if not tracked then
+    return { result }
+end
+
+local reply = { result, previous_position, position(KEYS[5], KEYS[6], member_of(KEYS[8], player), result) }
+if #previous_top > 0 then
+    local current_top = {}
+    for _, member in ipairs(top_members(KEYS[5], KEYS[6])) do
+        current_top[member] = true
+    end
+
+    for _, member in ipairs(previous_top) do
+        local id = player_of(member)
+        if not current_top[member] and id ~= player then
+            local score = redis.call('ZSCORE', KEYS[5], member)
+            reply[#reply + 1] = id
+            reply[#reply + 1] = score
+            reply[#reply + 1] = position(KEYS[5], KEYS[6], member, score)
+        end
+    end
+end
+
+return reply
@@ -0,0 +1,62 @@
+-- Applies the rank updates of a player on many leaderboards at once. Every
+-- update is checked before any of them is applied, so a rejected update
+-- prevents all the other ones from changing anything.
+--
+-- Each update has the keys and the arguments of the upsert rank script, which
+-- is included below as a function. The keys of the updates come one after the
+-- other, and so do their arguments, each preceded by the number of keys and
+-- arguments of the update.
+--
+-- ARGV[1] number of updates
+-- ARGV[n] number of keys of the update
+-- ARGV[n+1] number of arguments of the update
+-- ARGV[n+2...] arguments of the update
+--
+-- Returns {0, rejection reason of each update (empty when the update is accepted)}
+-- when any update is rejected, otherwise {1, upsert rank script reply of each update}
+
+local function upsert_rank(KEYS, ARGV)
+-- {{upsert_rank}}
+end
+
+local updates = {}
+local key, arg = 1, 2
+for i = 1, tonumber(ARGV[1]) do
+    local keys_count, args_count = tonumber(ARGV[arg]), tonumber(ARGV[arg + 1])
+    updates[i] = {
+        keys = { unpack(KEYS, key, key + keys_count - 1) },
+        args = { unpack(ARGV, arg + 2, arg + 1 + args_count) },
+    }
+
+    key = key + keys_count
+    arg = arg + 2 + args_count
+end
+
+-- Same checks the upsert rank script makes before changing anything
+local reasons, rejected = {}, false
+for i, update in ipairs(updates) do
+    local keys, args = update.keys, update.args
+
+    reasons[i] = ''
+    if redis.call('HEXISTS', keys[1], args[1]) == 1 then
+        reasons[i] = 'player banned'
+    else
+        local limit = tonumber(args[9])
+        if limit > 0 and tonumber(redis.call('GET', keys[4]) or 0) >= limit then
+            reasons[i] = 'too many submissions'
+        end
+    end
+
+    rejected = rejected or reasons[i] ~= ''
+end
+
+if rejected then
+    return { 0, reasons }
+end
+
+local replies = {}
+for i, update in ipairs(updates) do
+    replies[i] = upsert_rank(update.keys, update.args)
+end
+
+return { 1, replies }
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Checks if the cursor is a stream entry ID, formatted as `{milliseconds}-{sequence}`
+func validStreamCursor(cursor string) bool {
+	ms, seq, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return false
+	}
+
+	if _, err := strconv.ParseUint(ms, 10, 64); err != nil {
+		return false
+	}
+
+	_, err := strconv.ParseUint(seq, 10, 64)
+	return err == nil
+}
+
+// Stream entry IDs start with the time, in milliseconds, that the entry was added
+func streamEntryTime(id string) time.Time {
+	ms, _, _ := strings.Cut(id, "-")
+	unix, _ := strconv.ParseInt(ms, 10, 64)
+
+	return time.UnixMilli(unix)
+}
+
+// Reads the stream entries, most recent first, starting right after the cursor. An empty cursor starts from the most recent one.
+// Also returns the cursor of the next page, which is empty on the last one
+func (c connection) readStreamPage(ctx context.Context, key, cursor string, limit int64) ([]redis.XMessage, string, error) {
+	start := "+"
+	if cursor != "" {
+		if !validStreamCursor(cursor) {
+			return nil, "", leaderboard.ErrInvalidCursor
+		}
+
+		start = "(" + cursor
+	}
+
+	// One more entry is read to know if there's a next page
+	messages, err := c.rdb.XRevRangeN(ctx, key, start, "-", limit+1).Result()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if int64(len(messages)) > limit {
+		messages = messages[:limit]
+		next = messages[len(messages)-1].ID
+	}
+
+	return messages, next, nil
+}
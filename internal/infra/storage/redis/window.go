@@ -0,0 +1,230 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gabapcia/gameblitz/internal/leaderboard"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	rollingRankingCacheTTL    = 5 * time.Second
+	rollingRankingCacheMinTTL = time.Second
+
+	// Bounds how long a merge may hold its lock and temporary keys, and how long an export keeps its copy of the ranking between chunks
+	rollingMergeTTL = time.Minute
+
+	// Number of merged entries read at once to build the index of distinct ranking values
+	rollingMergeChunkSize = 5000
+
+	// Number of buckets merged by each ZUNIONSTORE, so a command never goes through the entries of the whole window at once
+	rollingMergeBucketsBatchSize = 24
+)
+
+// ZUNIONSTORE aggregate used to merge the buckets of each aggregation mode supported by the rolling windows
+var rollingAggregates = map[string]string{
+	leaderboard.AggregationModeInc:   "SUM",
+	leaderboard.AggregationModeMax:   "MAX",
+	leaderboard.AggregationModeMin:   "MIN",
+	leaderboard.AggregationModeCount: "SUM",
+}
+
+func buildRankingMergeLockKey(rankingKey string) string {
+	return fmt.Sprintf("%s:merging", rankingKey)
+}
+
+func buildRankingBucketKey(rankingKey string, bucket int64) string {
+	return fmt.Sprintf("%s:bucket:%d", rankingKey, bucket)
+}
+
+// Buckets are numbered by the window steps elapsed since the Unix epoch
+func rollingBucketAt(t time.Time) int64 {
+	return t.Unix() / int64(leaderboard.RollingWindowStep/time.Second)
+}
+
+// Returns the buckets of the window that ends on the time provided, most recent first
+func rollingBuckets(window time.Duration, at time.Time) []int64 {
+	var (
+		last    = rollingBucketAt(at)
+		buckets = make([]int64, window/leaderboard.RollingWindowStep)
+	)
+	for i := range buckets {
+		buckets[i] = last - int64(i)
+	}
+
+	return buckets
+}
+
+// Buckets outlive the window by one step, so the oldest one is still merged while it's part of the window
+func rollingBucketTTL(window time.Duration) time.Duration {
+	return window + leaderboard.RollingWindowStep
+}
+
+// Merges the window buckets into the ranking provided with ZUNIONSTORE, a batch of buckets at a time on top of the ones already merged.
+// Each command still goes through every player ranked on the window, but not through the entries of every bucket at once.
+// The index of distinct ranking values (used by the DENSE ranking mode) is built from the merged ranking chunk by chunk.
+// Both are built on temporary keys and renamed at the end, so readers never see them half done
+func (c connection) mergeRollingBuckets(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope, dest string, ttl time.Duration) error {
+	aggregate, ok := rollingAggregates[lb.AggregationMode]
+	if !ok {
+		return leaderboard.ErrInvalidAggregationMode
+	}
+
+	var (
+		key         = buildRankingKey(lb.ID, period, scope)
+		buckets     = rollingBuckets(lb.RollingWindow, time.Now())
+		bucketsKeys = make([]string, len(buckets))
+		tmp         = fmt.Sprintf("%s:merge:%s", dest, uuid.NewString())
+		tmpValues   = buildRankingValuesKey(tmp)
+	)
+	for i, bucket := range buckets {
+		bucketsKeys[i] = buildRankingBucketKey(key, bucket)
+	}
+
+	// The temporary keys expire by themselves if the merge is interrupted
+	var total int64
+	for start := 0; start < len(bucketsKeys); start += rollingMergeBucketsBatchSize {
+		batch := bucketsKeys[start:min(start+rollingMergeBucketsBatchSize, len(bucketsKeys))]
+		if start > 0 {
+			batch = append([]string{tmp}, batch...)
+		}
+
+		var union *redis.IntCmd
+		_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			union = pipe.ZUnionStore(ctx, tmp, &redis.ZStore{Keys: batch, Aggregate: aggregate})
+			pipe.PExpire(ctx, tmp, rollingMergeTTL)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		total = union.Val()
+	}
+
+	if lb.RankingMode == leaderboard.RankingModeDense {
+		previous := math.NaN()
+		for start := int64(0); start < total; start += rollingMergeChunkSize {
+			entries, err := c.rdb.ZRangeWithScores(ctx, tmp, start, start+rollingMergeChunkSize-1).Result()
+			if err != nil {
+				return err
+			}
+
+			// Entries come sorted by their value, so the repeated ones are next to each other
+			values := make([]redis.Z, 0)
+			for _, entry := range entries {
+				if entry.Score == previous {
+					continue
+				}
+
+				values = append(values, redis.Z{Score: entry.Score, Member: strconv.FormatFloat(entry.Score, 'f', -1, 64)})
+				previous = entry.Score
+			}
+
+			if len(values) == 0 {
+				continue
+			}
+
+			if _, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.ZAdd(ctx, tmpValues, values...)
+				pipe.PExpire(ctx, tmpValues, rollingMergeTTL)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		// Nothing is stored by ZUNIONSTORE when no player is ranked on the window
+		pipe.Del(ctx, dest, buildRankingValuesKey(dest))
+		if total == 0 {
+			return nil
+		}
+
+		pipe.Rename(ctx, tmp, dest)
+		pipe.PExpire(ctx, dest, ttl)
+		if lb.RankingMode == leaderboard.RankingModeDense {
+			pipe.Rename(ctx, tmpValues, buildRankingValuesKey(dest))
+			pipe.PExpire(ctx, buildRankingValuesKey(dest), ttl)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// Refreshes the ranking cache of rolling leaderboards with the buckets of the current window. Other leaderboards are left as they are
+func (c connection) mergeRankingBuckets(ctx context.Context, lb leaderboard.Leaderboard, period string, scope leaderboard.Scope) error {
+	if !lb.Rolling() {
+		return nil
+	}
+
+	var (
+		key  = buildRankingKey(lb.ID, period, scope)
+		lock = buildRankingMergeLockKey(key)
+	)
+
+	merge, err := claimMergeScript.Run(ctx, c.rdb, []string{key, buildRankingValuesKey(key), lock}, rollingRankingCacheTTL.Milliseconds(), rollingRankingCacheMinTTL.Milliseconds(), rollingMergeTTL.Milliseconds()).Bool()
+	if err != nil || !merge {
+		return err
+	}
+	defer c.rdb.Del(context.Background(), lock)
+
+	return c.mergeRollingBuckets(ctx, lb, period, scope, key, rollingRankingCacheTTL)
+}
+
+// Same as mergeRankingBuckets for the global ranking of many leaderboards. The merges are claimed in a single round trip,
+// so only the rolling leaderboards whose cache is stale are merged
+func (c connection) mergeLeaderboardsRankingBuckets(ctx context.Context, leaderboards []leaderboard.Leaderboard, periods []string) error {
+	var (
+		rolling = make([]int, 0, len(leaderboards))
+		cmds    = make([]*redis.Cmd, 0, len(leaderboards))
+	)
+	// The script is sent in full, since a script missing from the cache makes every command of the pipeline fail
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, lb := range leaderboards {
+			if !lb.Rolling() {
+				continue
+			}
+
+			key := buildRankingKey(lb.ID, periods[i], leaderboard.Scope{})
+			keys := []string{key, buildRankingValuesKey(key), buildRankingMergeLockKey(key)}
+
+			rolling = append(rolling, i)
+			cmds = append(cmds, claimMergeScript.Eval(ctx, pipe, keys, rollingRankingCacheTTL.Milliseconds(), rollingRankingCacheMinTTL.Milliseconds(), rollingMergeTTL.Milliseconds()))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for j, i := range rolling {
+		merge, err := cmds[j].Bool()
+		if err != nil {
+			return err
+		}
+
+		if !merge {
+			continue
+		}
+
+		key := buildRankingKey(leaderboards[i].ID, periods[i], leaderboard.Scope{})
+		err = c.mergeRollingBuckets(ctx, leaderboards[i], periods[i], leaderboard.Scope{}, key, rollingRankingCacheTTL)
+		c.rdb.Del(context.Background(), buildRankingMergeLockKey(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrPlayerBanned     = errors.New("player banned")
+	ErrBanNotFound      = errors.New("ban not found")
+	ErrInvalidBanReason = errors.New("invalid ban reason")
+)
+
+const MaxBanReasonLength = 256
+
+type Ban struct {
+	LeaderboardID string    // Leaderboard's ID
+	PlayerID      string    // Player's ID
+	Reason        string    // Why the player was banned
+	BannedAt      time.Time // Time that the player was banned
+}
+
+type Bans struct {
+	Total int64 // Number of players banned from the leaderboard
+	Bans  []Ban // Bans page, most recent first
+}
+
+func BuildBanPlayerFunc(banPlayerFunc StorageBanPlayerFunc, removePlayerRankFunc StorageRemovePlayerRankFunc) BanPlayerFunc {
+	return func(ctx context.Context, lb Leaderboard, playerID, reason string) (Ban, error) {
+		if playerID == "" {
+			return Ban{}, ErrInvalidPlayerID
+		}
+
+		if len(reason) > MaxBanReasonLength {
+			return Ban{}, ErrInvalidBanReason
+		}
+
+		ban := Ban{
+			LeaderboardID: lb.ID,
+			PlayerID:      playerID,
+			Reason:        reason,
+			BannedAt:      time.Now(),
+		}
+
+		// The ban must be stored first so the player can't be ranked again while its entry is removed
+		if err := banPlayerFunc(ctx, ban); err != nil {
+			return Ban{}, err
+		}
+
+		if err := removePlayerRankFunc(ctx, lb, lb.CurrentPeriod(), playerID); err != nil && !errors.Is(err, ErrPlayerRankNotFound) {
+			return Ban{}, err
+		}
+
+		return ban, nil
+	}
+}
+
+func BuildBansFunc(getBansFunc StorageGetBansFunc) BansFunc {
+	return func(ctx context.Context, lb Leaderboard, page, limit int64) (Bans, error) {
+		if page < MinPageNumber {
+			return Bans{}, ErrInvalidPageNumber
+		}
+
+		if limit < MinLimitNumber || limit > MaxLimitNumber {
+			return Bans{}, ErrInvalidLimitNumber
+		}
+
+		return getBansFunc(ctx, lb.ID, page, limit)
+	}
+}
+
+func BuildUnbanPlayerFunc(unbanPlayerFunc StorageUnbanPlayerFunc) UnbanPlayerFunc {
+	return func(ctx context.Context, lb Leaderboard, playerID string) error {
+		return unbanPlayerFunc(ctx, lb.ID, playerID)
+	}
+}
@@ -0,0 +1,149 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBanPlayerFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		var banned bool
+
+		banPlayerFunc := BuildBanPlayerFunc(
+			func(ctx context.Context, ban Ban) error {
+				assert.Equal(t, leaderboardID, ban.LeaderboardID)
+				assert.Equal(t, playerID, ban.PlayerID)
+				assert.Equal(t, "cheating", ban.Reason)
+				banned = true
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period, id string) error {
+				assert.True(t, banned)
+				return nil
+			},
+		)
+
+		ban, err := banPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "cheating")
+		assert.NoError(t, err)
+		assert.Equal(t, playerID, ban.PlayerID)
+		assert.False(t, ban.BannedAt.IsZero())
+	})
+
+	t.Run("Player Not Ranked", func(t *testing.T) {
+		banPlayerFunc := BuildBanPlayerFunc(
+			func(ctx context.Context, ban Ban) error {
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period, id string) error {
+				return ErrPlayerRankNotFound
+			},
+		)
+
+		_, err := banPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Player ID", func(t *testing.T) {
+		banPlayerFunc := BuildBanPlayerFunc(nil, nil)
+
+		_, err := banPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPlayerID)
+	})
+
+	t.Run("Invalid Ban Reason", func(t *testing.T) {
+		banPlayerFunc := BuildBanPlayerFunc(nil, nil)
+
+		_, err := banPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, strings.Repeat("a", MaxBanReasonLength+1))
+		assert.ErrorIs(t, err, ErrInvalidBanReason)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		var errRandom = errors.New("random error")
+
+		banPlayerFunc := BuildBanPlayerFunc(
+			func(ctx context.Context, ban Ban) error {
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period, id string) error {
+				return errRandom
+			},
+		)
+
+		_, err := banPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "")
+		assert.ErrorIs(t, err, errRandom)
+	})
+}
+
+func TestBuildBansFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		bansFunc := BuildBansFunc(func(ctx context.Context, id string, page, limit int64) (Bans, error) {
+			assert.Equal(t, leaderboardID, id)
+			return Bans{Total: 1, Bans: []Ban{{LeaderboardID: id, PlayerID: uuid.NewString()}}}, nil
+		})
+
+		bans, err := bansFunc(ctx, Leaderboard{ID: leaderboardID}, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, bans.Bans, 1)
+	})
+
+	t.Run("Invalid Page Number", func(t *testing.T) {
+		bansFunc := BuildBansFunc(nil)
+
+		_, err := bansFunc(ctx, Leaderboard{ID: leaderboardID}, MinPageNumber-1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPageNumber)
+	})
+
+	t.Run("Invalid Limit Number", func(t *testing.T) {
+		bansFunc := BuildBansFunc(nil)
+
+		_, err := bansFunc(ctx, Leaderboard{ID: leaderboardID}, 0, MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+}
+
+func TestBuildUnbanPlayerFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		unbanPlayerFunc := BuildUnbanPlayerFunc(func(ctx context.Context, id, player string) error {
+			assert.Equal(t, leaderboardID, id)
+			assert.Equal(t, playerID, player)
+			return nil
+		})
+
+		err := unbanPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Ban Not Found", func(t *testing.T) {
+		unbanPlayerFunc := BuildUnbanPlayerFunc(func(ctx context.Context, id, player string) error {
+			return ErrBanNotFound
+		})
+
+		err := unbanPlayerFunc(ctx, Leaderboard{ID: leaderboardID}, playerID)
+		assert.ErrorIs(t, err, ErrBanNotFound)
+	})
+}
@@ -0,0 +1,65 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+const ExportChunkSize = 1000 // Number of ranks read from the storage at a time while exporting a ranking
+
+// Rankings that are still open may change while being exported, so a player can show up on two chunks or on none
+type Export struct {
+	Period string                                                          // Ranking period ID
+	Stream func(ctx context.Context, write func(ranks []Rank) error) error // Writes the whole ranking chunk by chunk, following the leaderboard ordering
+}
+
+func BuildExportRankingFunc(streamRankingFunc StorageStreamRankingFunc) ExportRankingFunc {
+	return func(ctx context.Context, lb Leaderboard, period string, scope Scope) (Export, error) {
+		period, err := lb.resolvePeriod(period)
+		if err != nil {
+			return Export{}, err
+		}
+
+		if err := lb.validateScope(scope); err != nil {
+			return Export{}, err
+		}
+
+		stream := func(ctx context.Context, write func(ranks []Rank) error) error {
+			return streamRankingFunc(ctx, lb, period, scope, ExportChunkSize, func(ranking Ranking) error {
+				lb.placeRanks(ranking.Ranks, ranking.Total)
+				return write(ranking.Ranks)
+			})
+		}
+
+		return Export{Period: period, Stream: stream}, nil
+	}
+}
+
+func BuildExportResultsFunc(getResultsFunc StorageGetResultsFunc, streamResultsFunc StorageStreamResultsFunc) ExportResultsFunc {
+	return func(ctx context.Context, lb Leaderboard, period string) (Export, error) {
+		if period == "" {
+			period = lb.previousPeriod()
+		}
+
+		if _, _, err := lb.PeriodBounds(period); err != nil {
+			return Export{}, err
+		}
+
+		// Only finalized periods can be exported, which is checked before anything is written
+		results, err := getResultsFunc(ctx, lb.ID, period, MinPageNumber, MinLimitNumber)
+		if err != nil {
+			return Export{}, err
+		}
+
+		stream := func(ctx context.Context, write func(ranks []Rank) error) error {
+			return streamResultsFunc(ctx, lb.ID, period, ExportChunkSize, func(ranks []Rank) error {
+				lb.placeRanks(ranks, results.Total)
+				return write(ranks)
+			})
+		}
+
+		return Export{Period: period, Stream: stream}, nil
+	}
+}
@@ -0,0 +1,121 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildExportRankingFunc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Ordering: OrderingDesc, Tiers: []Tier{{Name: "Gold", Percentile: 50}}}
+
+		exportRankingFunc := BuildExportRankingFunc(func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, chunkSize int64, write func(ranking Ranking) error) error {
+			assert.Equal(t, lb.ID, leaderboard.ID)
+			assert.Equal(t, int64(ExportChunkSize), chunkSize)
+
+			if err := write(Ranking{Total: 4, Ranks: buildRanks(lb.ID, 0, 2)}); err != nil {
+				return err
+			}
+
+			return write(Ranking{Total: 4, Ranks: buildRanks(lb.ID, 2, 2)})
+		})
+
+		export, err := exportRankingFunc(ctx, lb, "", Scope{})
+		assert.NoError(t, err)
+		assert.Equal(t, lb.CurrentPeriod(), export.Period)
+
+		exported := make([]Rank, 0)
+		err = export.Stream(ctx, func(ranks []Rank) error {
+			exported = append(exported, ranks...)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, exported, 4)
+		assert.Equal(t, "Gold", exported[1].Tier)
+		assert.Empty(t, exported[3].Tier)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		exportRankingFunc := BuildExportRankingFunc(nil)
+
+		_, err := exportRankingFunc(ctx, Leaderboard{Recurrence: RecurrenceWeekly}, "2024-01", Scope{})
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("Invalid Scope", func(t *testing.T) {
+		exportRankingFunc := BuildExportRankingFunc(nil)
+
+		_, err := exportRankingFunc(ctx, Leaderboard{Scopes: []string{"region"}}, "", Scope{Dimension: "platform", Value: "pc"})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+
+	t.Run("Write Error", func(t *testing.T) {
+		exportRankingFunc := BuildExportRankingFunc(func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, chunkSize int64, write func(ranking Ranking) error) error {
+			return write(Ranking{Total: 1, Ranks: buildRanks(leaderboard.ID, 0, 1)})
+		})
+
+		export, err := exportRankingFunc(ctx, Leaderboard{ID: uuid.NewString()}, "", Scope{})
+		assert.NoError(t, err)
+
+		err = export.Stream(ctx, func(ranks []Rank) error {
+			return errors.New("any error")
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildExportResultsFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+		lb  = Leaderboard{ID: uuid.NewString(), Recurrence: RecurrenceDaily, StartAt: time.Now().AddDate(0, 0, -7)}
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		period := lb.PeriodAt(time.Now().AddDate(0, 0, -1))
+
+		exportResultsFunc := BuildExportResultsFunc(func(ctx context.Context, leaderboardID, p string, page, limit int64) (Results, error) {
+			assert.Equal(t, period, p)
+			return Results{LeaderboardID: leaderboardID, Period: p, Total: 3}, nil
+		}, func(ctx context.Context, leaderboardID, p string, chunkSize int64, write func(ranks []Rank) error) error {
+			assert.Equal(t, lb.ID, leaderboardID)
+			assert.Equal(t, period, p)
+			return write(buildRanks(leaderboardID, 0, 3))
+		})
+
+		export, err := exportResultsFunc(ctx, lb, "")
+		assert.NoError(t, err)
+		assert.Equal(t, period, export.Period)
+
+		exported := make([]Rank, 0)
+		err = export.Stream(ctx, func(ranks []Rank) error {
+			exported = append(exported, ranks...)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, exported, 3)
+		assert.NotZero(t, exported[2].Percentile)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		exportResultsFunc := BuildExportResultsFunc(nil, nil)
+
+		_, err := exportResultsFunc(ctx, lb, "2024-W01")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("Results Not Found", func(t *testing.T) {
+		exportResultsFunc := BuildExportResultsFunc(func(ctx context.Context, leaderboardID, period string, page, limit int64) (Results, error) {
+			return Results{}, ErrResultsNotFound
+		}, nil)
+
+		_, err := exportResultsFunc(ctx, lb, "")
+		assert.ErrorIs(t, err, ErrResultsNotFound)
+	})
+}
@@ -0,0 +1,38 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type Caller struct {
+	GameID string // ID of the game that made the submission
+	Client string // Client that made the submission on behalf of the game
+}
+
+type Submission struct {
+	ID          string    // Submission ID, used as the pagination cursor
+	Period      string    // Ranking period ID that received the submission
+	Value       float64   // Value submitted
+	Score       float64   // Player's rank value right after the submission
+	Caller                // Who made the submission
+	SubmittedAt time.Time // Time that the submission was applied
+}
+
+type History struct {
+	Submissions []Submission // History page, most recent first
+	NextCursor  string       // Cursor of the next page. Empty on the last one
+}
+
+func BuildPlayerHistoryFunc(getPlayerHistoryFunc StorageGetPlayerHistoryFunc) PlayerHistoryFunc {
+	return func(ctx context.Context, lb Leaderboard, playerID, cursor string, limit int64) (History, error) {
+		if limit < MinLimitNumber || limit > MaxLimitNumber {
+			return History{}, ErrInvalidLimitNumber
+		}
+
+		return getPlayerHistoryFunc(ctx, lb.ID, playerID, cursor, limit)
+	}
+}
@@ -0,0 +1,52 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPlayerHistoryFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		playerHistoryFunc := BuildPlayerHistoryFunc(func(ctx context.Context, id, player, cursor string, limit int64) (History, error) {
+			assert.Equal(t, leaderboardID, id)
+			assert.Equal(t, playerID, player)
+			assert.Equal(t, "1-0", cursor)
+			assert.Equal(t, int64(10), limit)
+			return History{Submissions: []Submission{{ID: "0-1", Value: 10, Score: 10}}}, nil
+		})
+
+		history, err := playerHistoryFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "1-0", 10)
+		assert.NoError(t, err)
+		assert.Len(t, history.Submissions, 1)
+		assert.Empty(t, history.NextCursor)
+	})
+
+	t.Run("Invalid Limit Number", func(t *testing.T) {
+		playerHistoryFunc := BuildPlayerHistoryFunc(nil)
+
+		_, err := playerHistoryFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "", MinLimitNumber-1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+
+		_, err = playerHistoryFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "", MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+
+	t.Run("Invalid Cursor", func(t *testing.T) {
+		playerHistoryFunc := BuildPlayerHistoryFunc(func(ctx context.Context, id, player, cursor string, limit int64) (History, error) {
+			return History{}, ErrInvalidCursor
+		})
+
+		_, err := playerHistoryFunc(ctx, Leaderboard{ID: leaderboardID}, playerID, "invalid", 10)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
@@ -14,19 +14,44 @@ var (
 	ErrInvalidStartDate       = errors.New("invalid start date")
 	ErrInvalidAggregationMode = errors.New("invalid aggregation mode")
 	ErrInvalidOrdering        = errors.New("invalid ordering")
+	ErrInvalidRankingMode     = errors.New("invalid ranking mode")
+	ErrInvalidTieBreakPolicy  = errors.New("invalid tie break policy")
+	ErrInvalidRecurrence      = errors.New("invalid recurrence")
+	ErrInvalidTimezone        = errors.New("invalid timezone")
 	ErrEndDateBeforeStartDate = errors.New("end date must be after the start date")
 
 	ErrInvalidLeaderboardID = errors.New("invalid leaderboard id")
 	ErrLeaderboardNotFound  = errors.New("leaderboard not found")
+	ErrInvalidStatus        = errors.New("invalid status")
 )
 
 const (
-	AggregationModeInc = "INC"
-	AggregationModeMax = "MAX"
-	AggregationModeMin = "MIN"
+	AggregationModeInc   = "INC"   // The value is added to the player's rank
+	AggregationModeMax   = "MAX"   // The player keeps its highest value
+	AggregationModeMin   = "MIN"   // The player keeps its lowest value
+	AggregationModeLast  = "LAST"  // The value replaces the player's rank
+	AggregationModeAvg   = "AVG"   // The player is ranked by the average of its values
+	AggregationModeCount = "COUNT" // The player is ranked by its number of submissions, ignoring their values
 
 	OrderingAsc  = "ASC"
 	OrderingDesc = "DESC"
+
+	RankingModeStandard = "STANDARD" // Tied players share the position and the next ones skip it (1, 2, 2, 4)
+	RankingModeDense    = "DENSE"    // Tied players share the position and the next ones don't skip it (1, 2, 2, 3)
+	RankingModeOrdinal  = "ORDINAL"  // Every player has its own position, even when tied (1, 2, 3, 4)
+
+	TieBreakPolicyEarliest = "EARLIEST" // The player that achieved the value first is ranked above
+	TieBreakPolicyLatest   = "LATEST"   // The player that achieved the value last is ranked above
+	TieBreakPolicyNone     = "NONE"     // Tied players are ranked by their IDs
+
+	RecurrenceNone    = "NONE"    // The leaderboard has a single ranking
+	RecurrenceDaily   = "DAILY"   // The ranking resets every day
+	RecurrenceWeekly  = "WEEKLY"  // The ranking resets every monday
+	RecurrenceMonthly = "MONTHLY" // The ranking resets every first day of the month
+
+	StatusActive  = "ACTIVE"  // The leaderboard accepts updates, or will once it starts
+	StatusClosed  = "CLOSED"  // The leaderboard reached its end time
+	StatusDeleted = "DELETED" // The leaderboard was deleted
 )
 
 var (
@@ -34,35 +59,97 @@ var (
 		AggregationModeInc,
 		AggregationModeMax,
 		AggregationModeMin,
+		AggregationModeLast,
+		AggregationModeAvg,
+		AggregationModeCount,
 	}
 	OrderingModes = []string{
 		OrderingAsc,
 		OrderingDesc,
 	}
+	RankingModes = []string{
+		RankingModeStandard,
+		RankingModeDense,
+		RankingModeOrdinal,
+	}
+	TieBreakPolicies = []string{
+		TieBreakPolicyEarliest,
+		TieBreakPolicyLatest,
+		TieBreakPolicyNone,
+	}
+	Recurrences = []string{
+		RecurrenceNone,
+		RecurrenceDaily,
+		RecurrenceWeekly,
+		RecurrenceMonthly,
+	}
+	Statuses = []string{
+		StatusActive,
+		StatusClosed,
+		StatusDeleted,
+	}
 )
 
 type NewLeaderboardData struct {
-	GameID          string    // The ID from the game that is responsible for the leaderboard
-	Name            string    // Leaderboard's name
-	Description     string    // Leaderboard's description
-	StartAt         time.Time // Time that the leaderboard should start working
-	EndAt           time.Time // Time that the leaderboard will be closed for new updates
-	AggregationMode string    // Data aggregation mode
-	Ordering        string    // Leaderboard ranking order
+	GameID          string          // The ID from the game that is responsible for the leaderboard
+	Name            string          // Leaderboard's name
+	Description     string          // Leaderboard's description
+	StartAt         time.Time       // Time that the leaderboard should start working
+	EndAt           time.Time       // Time that the leaderboard will be closed for new updates
+	AggregationMode string          // Data aggregation mode
+	Ordering        string          // Leaderboard ranking order
+	RankingMode     string          // How tied players are positioned on the ranking
+	TieBreakPolicy  string          // How tied players are ordered on the ranking
+	Recurrence      string          // How often the ranking resets
+	Timezone        string          // IANA timezone used to calculate the ranking periods
+	RewardTiers     []RewardTier    // Tiers awarded to the players when each period closes
+	Tiers           []Tier          // Tiers that the players are placed on by their percentile or value, like bronze, silver and gold
+	Scopes          []string        // Dimensions that partition the ranking, like region or platform
+	SubmissionRules SubmissionRules // Rules that every submission must follow to be applied
+	RollingWindow   time.Duration   // Only the submissions made on this window count towards the ranking, and no rank change is notified. Zero when every submission counts
+	NotifyTop       int64           // Size of the top whose players are notified when a submission pushes them out of it. Zero disables these notifications
+	StatisticID     string          // Statistic whose player values feed the ranking. Empty when the ranking is only fed by its own submissions
 }
 
 type Leaderboard struct {
-	CreatedAt       time.Time // Time that the leaderboard was created
-	UpdatedAt       time.Time // Last time that the leaderboard info was updated
-	DeletedAt       time.Time // Time that the leaderboard was deleted
-	ID              string    // Leaderboard's ID
-	GameID          string    // The ID from the game that is responsible for the leaderboard
-	Name            string    // Leaderboard's name
-	Description     string    // Leaderboard's description
-	StartAt         time.Time // Time that the leaderboard should start working
-	EndAt           time.Time // Time that the leaderboard will be closed for new updates
-	AggregationMode string    // Data aggregation mode
-	Ordering        string    // Leaderboard ranking order
+	CreatedAt       time.Time       // Time that the leaderboard was created
+	UpdatedAt       time.Time       // Last time that the leaderboard info was updated
+	DeletedAt       time.Time       // Time that the leaderboard was deleted
+	ID              string          // Leaderboard's ID
+	GameID          string          // The ID from the game that is responsible for the leaderboard
+	Name            string          // Leaderboard's name
+	Description     string          // Leaderboard's description
+	StartAt         time.Time       // Time that the leaderboard should start working
+	EndAt           time.Time       // Time that the leaderboard will be closed for new updates
+	AggregationMode string          // Data aggregation mode
+	Ordering        string          // Leaderboard ranking order
+	RankingMode     string          // How tied players are positioned on the ranking
+	TieBreakPolicy  string          // How tied players are ordered on the ranking
+	Recurrence      string          // How often the ranking resets
+	Timezone        string          // IANA timezone used to calculate the ranking periods
+	RewardTiers     []RewardTier    // Tiers awarded to the players when each period closes
+	Tiers           []Tier          // Tiers that the players are placed on by their percentile or value, like bronze, silver and gold
+	Scopes          []string        // Dimensions that partition the ranking, like region or platform
+	SubmissionRules SubmissionRules // Rules that every submission must follow to be applied
+	RollingWindow   time.Duration   // Only the submissions made on this window count towards the ranking, and no rank change is notified. Zero when every submission counts
+	NotifyTop       int64           // Size of the top whose players are notified when a submission pushes them out of it. Zero disables these notifications
+	StatisticID     string          // Statistic whose player values feed the ranking. Empty when the ranking is only fed by its own submissions
+}
+
+type UpdateLeaderboardData struct {
+	Name        *string    // New name. Nil keeps the current one
+	Description *string    // New description. Nil keeps the current one
+	EndAt       *time.Time // New end time. Nil keeps the current one and a zero time removes it
+}
+
+type LeaderboardsFilter struct {
+	Status string // Status of the leaderboards. Empty lists every leaderboard that's not deleted
+	Search string // Text that the leaderboard name must contain, ignoring the case
+}
+
+type Leaderboards struct {
+	Total        int64         // Number of leaderboards that match the filters
+	Leaderboards []Leaderboard // Leaderboards page, most recent first
 }
 
 func (l NewLeaderboardData) validate() error {
@@ -88,6 +175,50 @@ func (l NewLeaderboardData) validate() error {
 		errList = append(errList, ErrInvalidOrdering)
 	}
 
+	if !slices.Contains(RankingModes, l.RankingMode) {
+		errList = append(errList, ErrInvalidRankingMode)
+	}
+
+	if !slices.Contains(TieBreakPolicies, l.TieBreakPolicy) {
+		errList = append(errList, ErrInvalidTieBreakPolicy)
+	}
+
+	if !slices.Contains(Recurrences, l.Recurrence) {
+		errList = append(errList, ErrInvalidRecurrence)
+	}
+
+	if _, err := time.LoadLocation(l.Timezone); err != nil {
+		errList = append(errList, ErrInvalidTimezone)
+	}
+
+	if err := validateRewardTiers(l.RewardTiers); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := validateTiers(l.Tiers); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := validateScopeDimensions(l.Scopes); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := l.SubmissionRules.validate(l.AggregationMode); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := l.validateRollingWindow(); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := l.validateNotifyTop(); err != nil {
+		errList = append(errList, err)
+	}
+
+	if err := l.validateStatisticBinding(); err != nil {
+		errList = append(errList, err)
+	}
+
 	if !l.EndAt.IsZero() && l.EndAt.Before(l.StartAt) {
 		errList = append(errList, ErrEndDateBeforeStartDate)
 	}
@@ -100,17 +231,71 @@ func (l NewLeaderboardData) validate() error {
 }
 
 func (l Leaderboard) Closed() bool {
-	now := time.Now()
-	return !l.DeletedAt.IsZero() || now.Before(l.StartAt) || (!l.EndAt.IsZero() && now.After(l.EndAt))
+	return l.PeriodClosed(l.CurrentPeriod())
 }
 
-func BuildCreateFunc(storageCreateFunc StorageCreateLeaderboardFunc) CreateFunc {
+func (l Leaderboard) Status() string {
+	switch {
+	case !l.DeletedAt.IsZero():
+		return StatusDeleted
+	case !l.EndAt.IsZero() && time.Now().After(l.EndAt):
+		return StatusClosed
+	default:
+		return StatusActive
+	}
+}
+
+// Returns the leaderboard config as it's provided on the creation, so it can be validated again
+func (l Leaderboard) data() NewLeaderboardData {
+	return NewLeaderboardData{
+		GameID:          l.GameID,
+		Name:            l.Name,
+		Description:     l.Description,
+		StartAt:         l.StartAt,
+		EndAt:           l.EndAt,
+		AggregationMode: l.AggregationMode,
+		Ordering:        l.Ordering,
+		RankingMode:     l.RankingMode,
+		TieBreakPolicy:  l.TieBreakPolicy,
+		Recurrence:      l.Recurrence,
+		Timezone:        l.Timezone,
+		RewardTiers:     l.RewardTiers,
+		Tiers:           l.Tiers,
+		Scopes:          l.Scopes,
+		SubmissionRules: l.SubmissionRules,
+		RollingWindow:   l.RollingWindow,
+		NotifyTop:       l.NotifyTop,
+		StatisticID:     l.StatisticID,
+	}
+}
+
+func BuildCreateFunc(
+	storageCreateFunc StorageCreateLeaderboardFunc,
+	scheduleFinalizationFunc StorageScheduleFinalizationFunc,
+	statisticExistsFunc StorageStatisticExistsFunc,
+) CreateFunc {
 	return func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
 		if err := data.validate(); err != nil {
 			return Leaderboard{}, err
 		}
 
-		return storageCreateFunc(ctx, data)
+		if err := data.checkStatistic(ctx, statisticExistsFunc); err != nil {
+			return Leaderboard{}, err
+		}
+
+		lb, err := storageCreateFunc(ctx, data)
+		if err != nil {
+			return Leaderboard{}, err
+		}
+
+		period := lb.firstPeriod()
+		if end := lb.PeriodEnd(period); !end.IsZero() {
+			if err := scheduleFinalizationFunc(ctx, Finalization{Leaderboard: lb, Period: period}, end); err != nil {
+				return Leaderboard{}, err
+			}
+		}
+
+		return lb, nil
 	}
 }
 
@@ -120,8 +305,114 @@ func BuildGetByIDAndGameIDFunc(storageGetFunc StorageGetLeaderboardByIDAndGameID
 	}
 }
 
+func BuildListFunc(storageListFunc StorageListLeaderboardsFunc) ListFunc {
+	return func(ctx context.Context, gameID, status, search string, page, limit int64) (Leaderboards, error) {
+		if status != "" && !slices.Contains(Statuses, status) {
+			return Leaderboards{}, ErrInvalidStatus
+		}
+
+		if page < MinPageNumber {
+			return Leaderboards{}, ErrInvalidPageNumber
+		}
+
+		if limit < MinLimitNumber || limit > MaxLimitNumber {
+			return Leaderboards{}, ErrInvalidLimitNumber
+		}
+
+		return storageListFunc(ctx, gameID, LeaderboardsFilter{Status: status, Search: search}, page, limit)
+	}
+}
+
+func BuildUpdateFunc(
+	storageGetFunc StorageGetLeaderboardByIDAndGameIDFunc,
+	storageUpdateFunc StorageUpdateLeaderboardFunc,
+	scheduleFinalizationFunc StorageScheduleFinalizationFunc,
+	completeFinalizationFunc StorageCompleteFinalizationFunc,
+	statisticExistsFunc StorageStatisticExistsFunc,
+) UpdateFunc {
+	return func(ctx context.Context, id, gameID string, data UpdateLeaderboardData) (Leaderboard, error) {
+		lb, err := storageGetFunc(ctx, id, gameID)
+		if err != nil {
+			return Leaderboard{}, err
+		}
+
+		if data.Name != nil {
+			lb.Name = *data.Name
+		}
+
+		if data.Description != nil {
+			lb.Description = *data.Description
+		}
+
+		endChanged := data.EndAt != nil && !data.EndAt.Equal(lb.EndAt)
+		if endChanged {
+			// The last period of an ended leaderboard may already be finalized, so it can't be reopened
+			if lb.Status() == StatusClosed {
+				return Leaderboard{}, ErrLeaderboardClosed
+			}
+
+			lb.EndAt = *data.EndAt
+		}
+
+		if err := lb.data().validate(); err != nil {
+			return Leaderboard{}, err
+		}
+
+		if err := lb.data().checkStatistic(ctx, statisticExistsFunc); err != nil {
+			return Leaderboard{}, err
+		}
+
+		lb, err = storageUpdateFunc(ctx, lb)
+		if err != nil {
+			return Leaderboard{}, err
+		}
+
+		if !endChanged {
+			return lb, nil
+		}
+
+		// The period in progress now closes at a different time
+		finalization := Finalization{Leaderboard: lb, Period: lb.firstPeriod()}
+		if end := lb.PeriodEnd(finalization.Period); !end.IsZero() {
+			err = scheduleFinalizationFunc(ctx, finalization, end)
+		} else {
+			err = completeFinalizationFunc(ctx, finalization)
+		}
+
+		if err != nil {
+			return Leaderboard{}, err
+		}
+
+		return lb, nil
+	}
+}
+
 func BuildSoftDeleteFunc(storageSoftDeleteFunc StorageSoftDeleteLeaderboardFunc) SoftDeleteFunc {
 	return func(ctx context.Context, id, gameID string) error {
 		return storageSoftDeleteFunc(ctx, id, gameID)
 	}
 }
+
+func BuildRestoreFunc(storageRestoreFunc StorageRestoreLeaderboardFunc, scheduleFinalizationFunc StorageScheduleFinalizationFunc) RestoreFunc {
+	return func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+		lb, err := storageRestoreFunc(ctx, id, gameID)
+		if err != nil {
+			return Leaderboard{}, err
+		}
+
+		// Finalizations that came due while the leaderboard was deleted were dropped.
+		// Closed leaderboards keep the results they had, so only the active ones are scheduled again
+		if lb.Status() != StatusActive {
+			return lb, nil
+		}
+
+		period := lb.firstPeriod()
+		if end := lb.PeriodEnd(period); !end.IsZero() {
+			if err := scheduleFinalizationFunc(ctx, Finalization{Leaderboard: lb, Period: period}, end); err != nil {
+				return Leaderboard{}, err
+			}
+		}
+
+		return lb, nil
+	}
+}
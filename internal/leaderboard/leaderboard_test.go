@@ -20,11 +20,33 @@ func TestValidate(t *testing.T) {
 			EndAt:           time.Time{},
 			AggregationMode: AggregationModeMax,
 			Ordering:        OrderingDesc,
+			RankingMode:     RankingModeStandard,
+			TieBreakPolicy:  TieBreakPolicyEarliest,
+			Recurrence:      RecurrenceWeekly,
+			Timezone:        "America/Sao_Paulo",
 		}
 
 		assert.NoError(t, data.validate())
 	})
 
+	t.Run("OK With Every Aggregation Mode", func(t *testing.T) {
+		for _, mode := range AggregationModes {
+			data := NewLeaderboardData{
+				GameID:          uuid.NewString(),
+				Name:            "Test Leaderboard",
+				StartAt:         time.Now(),
+				AggregationMode: mode,
+				Ordering:        OrderingDesc,
+				RankingMode:     RankingModeStandard,
+				TieBreakPolicy:  TieBreakPolicyEarliest,
+				Recurrence:      RecurrenceNone,
+				Timezone:        "UTC",
+			}
+
+			assert.NoError(t, data.validate(), mode)
+		}
+	})
+
 	t.Run("Invalid Fields", func(t *testing.T) {
 		data := NewLeaderboardData{
 			GameID:          "",
@@ -34,6 +56,14 @@ func TestValidate(t *testing.T) {
 			EndAt:           time.Time{},
 			AggregationMode: "INVALID",
 			Ordering:        "INVALID",
+			RankingMode:     "INVALID",
+			TieBreakPolicy:  "INVALID",
+			Recurrence:      "INVALID",
+			Timezone:        "INVALID",
+			RewardTiers:     []RewardTier{{Name: ""}},
+			Scopes:          []string{"region:eu"},
+			SubmissionRules: SubmissionRules{MaxIncrease: 10},
+			RollingWindow:   time.Minute,
 		}
 
 		assert.ErrorIs(t, data.validate(), ErrValidationError)
@@ -42,6 +72,14 @@ func TestValidate(t *testing.T) {
 		assert.ErrorIs(t, data.validate(), ErrInvalidStartDate)
 		assert.ErrorIs(t, data.validate(), ErrInvalidAggregationMode)
 		assert.ErrorIs(t, data.validate(), ErrInvalidOrdering)
+		assert.ErrorIs(t, data.validate(), ErrInvalidRankingMode)
+		assert.ErrorIs(t, data.validate(), ErrInvalidTieBreakPolicy)
+		assert.ErrorIs(t, data.validate(), ErrInvalidRecurrence)
+		assert.ErrorIs(t, data.validate(), ErrInvalidTimezone)
+		assert.ErrorIs(t, data.validate(), ErrInvalidRewardTier)
+		assert.ErrorIs(t, data.validate(), ErrInvalidScope)
+		assert.ErrorIs(t, data.validate(), ErrInvalidSubmissionRules)
+		assert.ErrorIs(t, data.validate(), ErrInvalidRollingWindow)
 	})
 
 	t.Run("End Date Before Start Date", func(t *testing.T) {
@@ -53,6 +91,10 @@ func TestValidate(t *testing.T) {
 			EndAt:           time.Now().Add(-24 * time.Hour),
 			AggregationMode: AggregationModeMax,
 			Ordering:        OrderingDesc,
+			RankingMode:     RankingModeStandard,
+			TieBreakPolicy:  TieBreakPolicyEarliest,
+			Recurrence:      RecurrenceWeekly,
+			Timezone:        "America/Sao_Paulo",
 		}
 
 		assert.ErrorIs(t, data.validate(), ErrValidationError)
@@ -94,6 +136,9 @@ func TestBuildCreateFunc(t *testing.T) {
 			EndAt:           time.Time{},
 			AggregationMode: AggregationModeMax,
 			Ordering:        OrderingDesc,
+			RankingMode:     RankingModeDense,
+			TieBreakPolicy:  TieBreakPolicyNone,
+			Recurrence:      RecurrenceNone,
 		}
 	)
 
@@ -109,7 +154,9 @@ func TestBuildCreateFunc(t *testing.T) {
 				AggregationMode: data.AggregationMode,
 				Ordering:        data.Ordering,
 			}, nil
-		})
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, nil)
 
 		leaderboard, err := createFunc(ctx, expectedData)
 
@@ -117,10 +164,49 @@ func TestBuildCreateFunc(t *testing.T) {
 		assert.Equal(t, expectedID, leaderboard.ID)
 	})
 
+	t.Run("Schedule Finalization", func(t *testing.T) {
+		data := expectedData
+		data.EndAt = time.Now().Add(24 * time.Hour)
+
+		var scheduled Finalization
+		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
+			return Leaderboard{ID: expectedID, StartAt: data.StartAt, EndAt: data.EndAt, Recurrence: data.Recurrence}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			scheduled = finalization
+			assert.Equal(t, data.EndAt, at)
+			return nil
+		}, nil)
+
+		leaderboard, err := createFunc(ctx, data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedID, scheduled.Leaderboard.ID)
+		assert.Equal(t, "", scheduled.Period)
+		assert.Equal(t, expectedID, leaderboard.ID)
+	})
+
+	t.Run("Schedule Error", func(t *testing.T) {
+		data := expectedData
+		data.Recurrence = RecurrenceDaily
+
+		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
+			return Leaderboard{ID: expectedID, StartAt: data.StartAt, Recurrence: data.Recurrence}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return errors.New("any error")
+		}, nil)
+
+		leaderboard, err := createFunc(ctx, data)
+
+		assert.Error(t, err)
+		assert.Empty(t, leaderboard.ID)
+	})
+
 	t.Run("Validation Error", func(t *testing.T) {
 		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
 			return Leaderboard{}, nil
-		})
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, nil)
 
 		leaderboard, err := createFunc(ctx, NewLeaderboardData{})
 
@@ -128,10 +214,47 @@ func TestBuildCreateFunc(t *testing.T) {
 		assert.Empty(t, leaderboard.ID)
 	})
 
+	t.Run("Bound Statistic", func(t *testing.T) {
+		data := expectedData
+		data.StatisticID = uuid.NewString()
+
+		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
+			return Leaderboard{ID: expectedID, StatisticID: data.StatisticID}, nil
+		}, nil, func(ctx context.Context, gameID, statisticID string) (bool, error) {
+			assert.Equal(t, data.GameID, gameID)
+			assert.Equal(t, data.StatisticID, statisticID)
+			return true, nil
+		})
+
+		leaderboard, err := createFunc(ctx, data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, data.StatisticID, leaderboard.StatisticID)
+	})
+
+	t.Run("Statistic Not Found", func(t *testing.T) {
+		data := expectedData
+		data.StatisticID = uuid.NewString()
+
+		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
+			t.Fatal("leaderboard must not be created")
+			return Leaderboard{}, nil
+		}, nil, func(ctx context.Context, gameID, statisticID string) (bool, error) {
+			return false, nil
+		})
+
+		_, err := createFunc(ctx, data)
+
+		assert.ErrorIs(t, err, ErrStatisticNotFound)
+		assert.ErrorIs(t, err, ErrValidationError)
+	})
+
 	t.Run("Random Error", func(t *testing.T) {
 		createFunc := BuildCreateFunc(func(ctx context.Context, data NewLeaderboardData) (Leaderboard, error) {
 			return Leaderboard{}, errors.New("any error")
-		})
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, nil)
 
 		leaderboard, err := createFunc(ctx, expectedData)
 
@@ -204,6 +327,231 @@ func TestBuildGetByIDAndGameIDFunc(t *testing.T) {
 	})
 }
 
+func TestLeaderboardStatus(t *testing.T) {
+	assert.Equal(t, StatusActive, Leaderboard{StartAt: time.Now().Add(time.Hour)}.Status())
+	assert.Equal(t, StatusActive, Leaderboard{EndAt: time.Now().Add(time.Hour)}.Status())
+	assert.Equal(t, StatusClosed, Leaderboard{EndAt: time.Now().Add(-time.Hour)}.Status())
+	assert.Equal(t, StatusDeleted, Leaderboard{EndAt: time.Now().Add(-time.Hour), DeletedAt: time.Now()}.Status())
+}
+
+func TestBuildListFunc(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		gameID       = uuid.NewString()
+		leaderboards = Leaderboards{
+			Total:        3,
+			Leaderboards: []Leaderboard{{ID: uuid.NewString(), Name: "Weekly Race"}},
+		}
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		listFunc := BuildListFunc(func(ctx context.Context, id string, filter LeaderboardsFilter, page, limit int64) (Leaderboards, error) {
+			assert.Equal(t, gameID, id)
+			assert.Equal(t, LeaderboardsFilter{Status: StatusActive, Search: "race"}, filter)
+			assert.Equal(t, int64(1), page)
+			assert.Equal(t, int64(2), limit)
+			return leaderboards, nil
+		})
+
+		result, err := listFunc(ctx, gameID, StatusActive, "race", 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, leaderboards, result)
+	})
+
+	t.Run("Invalid Params", func(t *testing.T) {
+		listFunc := BuildListFunc(nil)
+
+		_, err := listFunc(ctx, gameID, "INVALID", "", 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+
+		_, err = listFunc(ctx, gameID, "", "", -1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPageNumber)
+
+		_, err = listFunc(ctx, gameID, "", "", 0, MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		listFunc := BuildListFunc(func(ctx context.Context, gameID string, filter LeaderboardsFilter, page, limit int64) (Leaderboards, error) {
+			return Leaderboards{}, errors.New("any error")
+		})
+
+		_, err := listFunc(ctx, gameID, "", "", 0, 10)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildUpdateFunc(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		current = Leaderboard{
+			ID:              uuid.NewString(),
+			GameID:          uuid.NewString(),
+			Name:            "Test Leaderboard",
+			Description:     "Test update leaderboard unit test",
+			StartAt:         time.Now().Add(-time.Hour),
+			EndAt:           time.Now().Add(24 * time.Hour),
+			AggregationMode: AggregationModeMax,
+			Ordering:        OrderingDesc,
+			RankingMode:     RankingModeOrdinal,
+			TieBreakPolicy:  TieBreakPolicyNone,
+			Recurrence:      RecurrenceNone,
+			Timezone:        "UTC",
+		}
+		storageGetFunc = func(lb Leaderboard) StorageGetLeaderboardByIDAndGameIDFunc {
+			return func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+				return lb, nil
+			}
+		}
+		storageUpdateFunc = func(ctx context.Context, lb Leaderboard) (Leaderboard, error) {
+			return lb, nil
+		}
+		name = "New Name"
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		updateFunc := BuildUpdateFunc(storageGetFunc(current), storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			t.Fatal("finalization must not be rescheduled")
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			t.Fatal("finalization must not be removed")
+			return nil
+		}, nil)
+
+		lb, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{Name: &name, EndAt: &current.EndAt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, name, lb.Name)
+		assert.Equal(t, current.Description, lb.Description)
+	})
+
+	t.Run("Reschedule Finalization", func(t *testing.T) {
+		endAt := time.Now().Add(48 * time.Hour)
+
+		var scheduledAt time.Time
+		updateFunc := BuildUpdateFunc(storageGetFunc(current), storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			assert.Equal(t, "", finalization.Period)
+			scheduledAt = at
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			t.Fatal("finalization must not be removed")
+			return nil
+		}, nil)
+
+		lb, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{EndAt: &endAt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, endAt, lb.EndAt)
+		assert.Equal(t, endAt, scheduledAt)
+	})
+
+	t.Run("Remove End Date", func(t *testing.T) {
+		var removed bool
+		updateFunc := BuildUpdateFunc(storageGetFunc(current), storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			t.Fatal("finalization must not be rescheduled")
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			removed = true
+			return nil
+		}, nil)
+
+		lb, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{EndAt: &time.Time{}})
+
+		assert.NoError(t, err)
+		assert.True(t, lb.EndAt.IsZero())
+		assert.True(t, removed)
+	})
+
+	t.Run("Leaderboard Closed", func(t *testing.T) {
+		closed := current
+		closed.EndAt = time.Now().Add(-time.Minute)
+
+		endAt := time.Now().Add(time.Hour)
+		updateFunc := BuildUpdateFunc(storageGetFunc(closed), storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			return nil
+		}, nil)
+
+		_, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{EndAt: &endAt})
+		assert.ErrorIs(t, err, ErrLeaderboardClosed)
+
+		lb, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{Name: &name})
+		assert.NoError(t, err)
+		assert.Equal(t, name, lb.Name)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		var (
+			emptyName = ""
+			endAt     = current.StartAt.Add(-time.Hour)
+		)
+
+		updateFunc := BuildUpdateFunc(storageGetFunc(current), func(ctx context.Context, lb Leaderboard) (Leaderboard, error) {
+			t.Fatal("invalid leaderboards must not be stored")
+			return lb, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			return nil
+		}, nil)
+
+		_, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{Name: &emptyName, EndAt: &endAt})
+
+		assert.ErrorIs(t, err, ErrValidationError)
+		assert.ErrorIs(t, err, ErrInvalidName)
+		assert.ErrorIs(t, err, ErrEndDateBeforeStartDate)
+	})
+
+	t.Run("Statistic Not Found", func(t *testing.T) {
+		bound := current
+		bound.StatisticID = uuid.NewString()
+
+		updateFunc := BuildUpdateFunc(storageGetFunc(bound), func(ctx context.Context, lb Leaderboard) (Leaderboard, error) {
+			t.Fatal("leaderboards bound to missing statistics must not be stored")
+			return lb, nil
+		}, nil, nil, func(ctx context.Context, gameID, statisticID string) (bool, error) {
+			assert.Equal(t, bound.GameID, gameID)
+			assert.Equal(t, bound.StatisticID, statisticID)
+			return false, nil
+		})
+
+		_, err := updateFunc(ctx, bound.ID, bound.GameID, UpdateLeaderboardData{Name: &name})
+
+		assert.ErrorIs(t, err, ErrStatisticNotFound)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		updateFunc := BuildUpdateFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{}, ErrLeaderboardNotFound
+		}, storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		}, func(ctx context.Context, finalization Finalization) error {
+			return nil
+		}, nil)
+
+		_, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{Name: &name})
+
+		assert.ErrorIs(t, err, ErrLeaderboardNotFound)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		endAt := time.Now().Add(48 * time.Hour)
+
+		updateFunc := BuildUpdateFunc(storageGetFunc(current), storageUpdateFunc, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return errors.New("any error")
+		}, func(ctx context.Context, finalization Finalization) error {
+			return nil
+		}, nil)
+
+		_, err := updateFunc(ctx, current.ID, current.GameID, UpdateLeaderboardData{EndAt: &endAt})
+
+		assert.Error(t, err)
+	})
+}
+
 func TestBuildSoftDeleteFunc(t *testing.T) {
 	var (
 		ctx           = context.Background()
@@ -251,3 +599,80 @@ func TestBuildSoftDeleteFunc(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBuildRestoreFunc(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		leaderboardID = uuid.NewString()
+		gameID        = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		restoreFunc := BuildRestoreFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{ID: id, GameID: gameID, StartAt: time.Now(), Recurrence: RecurrenceNone}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			assert.Fail(t, "leaderboards without an end must not be finalized")
+			return nil
+		})
+
+		leaderboard, err := restoreFunc(ctx, leaderboardID, gameID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, leaderboardID, leaderboard.ID)
+	})
+
+	t.Run("Schedule Finalization", func(t *testing.T) {
+		var scheduled Finalization
+		restoreFunc := BuildRestoreFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{ID: id, GameID: gameID, StartAt: time.Now(), Recurrence: RecurrenceDaily}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			scheduled = finalization
+			assert.Equal(t, finalization.Leaderboard.PeriodEnd(finalization.Period), at)
+			return nil
+		})
+
+		leaderboard, err := restoreFunc(ctx, leaderboardID, gameID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, leaderboardID, scheduled.Leaderboard.ID)
+		assert.Equal(t, leaderboard.CurrentPeriod(), scheduled.Period)
+	})
+
+	t.Run("Closed Leaderboard", func(t *testing.T) {
+		restoreFunc := BuildRestoreFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{ID: id, GameID: gameID, StartAt: time.Now().Add(-time.Hour), EndAt: time.Now().Add(-time.Minute)}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			assert.Fail(t, "closed leaderboards must not be finalized again")
+			return nil
+		})
+
+		leaderboard, err := restoreFunc(ctx, leaderboardID, gameID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusClosed, leaderboard.Status())
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		restoreFunc := BuildRestoreFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{}, ErrLeaderboardNotFound
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return nil
+		})
+
+		_, err := restoreFunc(ctx, leaderboardID, gameID)
+
+		assert.ErrorIs(t, err, ErrLeaderboardNotFound)
+	})
+
+	t.Run("Schedule Error", func(t *testing.T) {
+		restoreFunc := BuildRestoreFunc(func(ctx context.Context, id, gameID string) (Leaderboard, error) {
+			return Leaderboard{ID: id, GameID: gameID, StartAt: time.Now(), Recurrence: RecurrenceWeekly}, nil
+		}, func(ctx context.Context, finalization Finalization, at time.Time) error {
+			return errors.New("any error")
+		})
+
+		_, err := restoreFunc(ctx, leaderboardID, gameID)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,17 @@
+package leaderboard
+
+import "context"
+
+type (
+	// Notify that the leaderboard period was finalized with its top ranks
+	NotifierLeaderboardFinalized func(ctx context.Context, leaderboard Leaderboard, results Results) error
+
+	// Notify that the player earned a reward tier when the leaderboard period was finalized
+	NotifierPlayerRewarded func(ctx context.Context, leaderboard Leaderboard, reward Reward) error
+
+	// Notify the player's position before and after a submission to the leaderboard. Not notified by rolling window leaderboards
+	NotifierPlayerRankChanged func(ctx context.Context, leaderboard Leaderboard, change RankChange) error
+
+	// Notify that a submission pushed the player out of the leaderboard top. Not notified by rolling window leaderboards
+	NotifierPlayerDisplaced func(ctx context.Context, leaderboard Leaderboard, change RankChange, displaced Rank) error
+)
@@ -0,0 +1,172 @@
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidPeriod = errors.New("invalid period")
+
+const (
+	dailyPeriodLayout   = "2006-01-02"
+	weeklyPeriodLayout  = "%04d-W%02d"
+	monthlyPeriodLayout = "2006-01"
+)
+
+func (l Leaderboard) location() *time.Location {
+	location, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// Returns the ID of the period that contains the time provided. Non recurring leaderboards have a single period with an empty ID
+func (l Leaderboard) PeriodAt(t time.Time) string {
+	t = t.In(l.location())
+
+	switch l.Recurrence {
+	case RecurrenceDaily:
+		return t.Format(dailyPeriodLayout)
+	case RecurrenceWeekly:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf(weeklyPeriodLayout, year, week)
+	case RecurrenceMonthly:
+		return t.Format(monthlyPeriodLayout)
+	default:
+		return ""
+	}
+}
+
+// Returns the ID of the period in progress
+func (l Leaderboard) CurrentPeriod() string {
+	return l.PeriodAt(time.Now())
+}
+
+// Returns the time range covered by the period. The end is exclusive and is zero for non recurring leaderboards
+func (l Leaderboard) PeriodBounds(period string) (time.Time, time.Time, error) {
+	location := l.location()
+
+	switch l.Recurrence {
+	case RecurrenceDaily:
+		start, err := time.ParseInLocation(dailyPeriodLayout, period, location)
+		if err != nil || start.Format(dailyPeriodLayout) != period {
+			return time.Time{}, time.Time{}, ErrInvalidPeriod
+		}
+
+		return start, start.AddDate(0, 0, 1), nil
+	case RecurrenceWeekly:
+		var year, week int
+		if _, err := fmt.Sscanf(period, weeklyPeriodLayout, &year, &week); err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidPeriod
+		}
+
+		// The 4th of January is always on the first ISO week of the year
+		start := time.Date(year, time.January, 4, 0, 0, 0, 0, location)
+		daysSinceMonday := (int(start.Weekday()) + 6) % 7
+		start = start.AddDate(0, 0, (week-1)*7-daysSinceMonday)
+		if fmt.Sprintf(weeklyPeriodLayout, year, week) != period || l.PeriodAt(start) != period {
+			return time.Time{}, time.Time{}, ErrInvalidPeriod
+		}
+
+		return start, start.AddDate(0, 0, 7), nil
+	case RecurrenceMonthly:
+		start, err := time.ParseInLocation(monthlyPeriodLayout, period, location)
+		if err != nil || start.Format(monthlyPeriodLayout) != period {
+			return time.Time{}, time.Time{}, ErrInvalidPeriod
+		}
+
+		return start, start.AddDate(0, 1, 0), nil
+	default:
+		if period != "" {
+			return time.Time{}, time.Time{}, ErrInvalidPeriod
+		}
+
+		return l.StartAt, l.EndAt, nil
+	}
+}
+
+// Checks if the period is closed for new updates
+func (l Leaderboard) PeriodClosed(period string) bool {
+	start, end, err := l.PeriodBounds(period)
+	if err != nil {
+		return true
+	}
+
+	now := time.Now()
+	return !l.DeletedAt.IsZero() ||
+		now.Before(l.StartAt) || now.Before(start) ||
+		(!l.EndAt.IsZero() && now.After(l.EndAt)) || (!end.IsZero() && !now.Before(end))
+}
+
+// Resolves the period requested, defaulting to the current one. Periods that haven't started yet are invalid
+func (l Leaderboard) resolvePeriod(period string) (string, error) {
+	if period == "" {
+		return l.CurrentPeriod(), nil
+	}
+
+	start, _, err := l.PeriodBounds(period)
+	if err != nil {
+		return "", err
+	}
+
+	if time.Now().Before(start) {
+		return "", ErrInvalidPeriod
+	}
+
+	return period, nil
+}
+
+// Returns the time that the period closes for good. A zero time means that the period never closes
+func (l Leaderboard) PeriodEnd(period string) time.Time {
+	_, end, err := l.PeriodBounds(period)
+	if err != nil {
+		return time.Time{}
+	}
+
+	if !l.EndAt.IsZero() && (end.IsZero() || l.EndAt.Before(end)) {
+		return l.EndAt
+	}
+
+	return end
+}
+
+func (l Leaderboard) recurring() bool {
+	return l.CurrentPeriod() != ""
+}
+
+// Returns the first period that accepts updates
+func (l Leaderboard) firstPeriod() string {
+	first := time.Now()
+	if l.StartAt.After(first) {
+		first = l.StartAt
+	}
+
+	return l.PeriodAt(first)
+}
+
+// Returns the period right after the one provided or an empty string if the leaderboard ends before it
+func (l Leaderboard) nextPeriod(period string) string {
+	if !l.recurring() {
+		return ""
+	}
+
+	_, end, err := l.PeriodBounds(period)
+	if err != nil || (!l.EndAt.IsZero() && !end.Before(l.EndAt)) {
+		return ""
+	}
+
+	return l.PeriodAt(end)
+}
+
+// Returns the last period that was closed when the leaderboard is recurring
+func (l Leaderboard) previousPeriod() string {
+	if !l.recurring() {
+		return ""
+	}
+
+	start, _, _ := l.PeriodBounds(l.CurrentPeriod())
+	return l.PeriodAt(start.Add(-time.Nanosecond))
+}
@@ -0,0 +1,113 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboardPeriodAt(t *testing.T) {
+	// Sunday in Sao Paulo, already Monday in UTC
+	at := time.Date(2024, time.December, 30, 1, 0, 0, 0, time.UTC)
+
+	t.Run("None", func(t *testing.T) {
+		assert.Equal(t, "", Leaderboard{Recurrence: RecurrenceNone}.PeriodAt(at))
+	})
+
+	t.Run("Daily", func(t *testing.T) {
+		assert.Equal(t, "2024-12-30", Leaderboard{Recurrence: RecurrenceDaily}.PeriodAt(at))
+		assert.Equal(t, "2024-12-29", Leaderboard{Recurrence: RecurrenceDaily, Timezone: "America/Sao_Paulo"}.PeriodAt(at))
+	})
+
+	t.Run("Weekly", func(t *testing.T) {
+		assert.Equal(t, "2025-W01", Leaderboard{Recurrence: RecurrenceWeekly}.PeriodAt(at))
+		assert.Equal(t, "2024-W52", Leaderboard{Recurrence: RecurrenceWeekly, Timezone: "America/Sao_Paulo"}.PeriodAt(at))
+	})
+
+	t.Run("Monthly", func(t *testing.T) {
+		assert.Equal(t, "2024-12", Leaderboard{Recurrence: RecurrenceMonthly}.PeriodAt(at))
+	})
+}
+
+func TestLeaderboardPeriodBounds(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+
+	t.Run("None", func(t *testing.T) {
+		lb := Leaderboard{Recurrence: RecurrenceNone, StartAt: time.Now()}
+
+		start, end, err := lb.PeriodBounds("")
+		assert.NoError(t, err)
+		assert.Equal(t, lb.StartAt, start)
+		assert.True(t, end.IsZero())
+	})
+
+	t.Run("Daily", func(t *testing.T) {
+		lb := Leaderboard{Recurrence: RecurrenceDaily, Timezone: "America/Sao_Paulo"}
+
+		start, end, err := lb.PeriodBounds("2024-12-29")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.December, 29, 0, 0, 0, 0, location), start)
+		assert.Equal(t, time.Date(2024, time.December, 30, 0, 0, 0, 0, location), end)
+	})
+
+	t.Run("Weekly", func(t *testing.T) {
+		lb := Leaderboard{Recurrence: RecurrenceWeekly}
+
+		start, end, err := lb.PeriodBounds("2025-W01")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC), end)
+
+		start, _, err = lb.PeriodBounds("2026-W53")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2026, time.December, 28, 0, 0, 0, 0, time.UTC), start)
+	})
+
+	t.Run("Monthly", func(t *testing.T) {
+		lb := Leaderboard{Recurrence: RecurrenceMonthly}
+
+		start, end, err := lb.PeriodBounds("2024-02")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		_, _, err := Leaderboard{Recurrence: RecurrenceNone}.PeriodBounds("2024-02")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+		_, _, err = Leaderboard{Recurrence: RecurrenceDaily}.PeriodBounds("2024-2-1")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+		_, _, err = Leaderboard{Recurrence: RecurrenceWeekly}.PeriodBounds("2025-W53")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+		_, _, err = Leaderboard{Recurrence: RecurrenceWeekly}.PeriodBounds("2025-W00")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+		_, _, err = Leaderboard{Recurrence: RecurrenceMonthly}.PeriodBounds("2024-13")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
+
+func TestLeaderboardPeriodClosed(t *testing.T) {
+	lb := Leaderboard{Recurrence: RecurrenceDaily, StartAt: time.Now().AddDate(0, 0, -7)}
+
+	t.Run("Current Period", func(t *testing.T) {
+		assert.Equal(t, false, lb.PeriodClosed(lb.CurrentPeriod()))
+	})
+
+	t.Run("Previous Period", func(t *testing.T) {
+		assert.Equal(t, true, lb.PeriodClosed(lb.PeriodAt(time.Now().AddDate(0, 0, -1))))
+	})
+
+	t.Run("Next Period", func(t *testing.T) {
+		assert.Equal(t, true, lb.PeriodClosed(lb.PeriodAt(time.Now().AddDate(0, 0, 1))))
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		assert.Equal(t, true, lb.PeriodClosed("INVALID"))
+	})
+}
@@ -0,0 +1,184 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+var ErrInvalidLeaderboardsNumber = errors.New("invalid leaderboards number")
+
+const (
+	MaxLeaderboardsNumber = 20
+	MinLeaderboardsNumber = 1
+)
+
+type LeaderboardRank struct {
+	Leaderboard Leaderboard // Leaderboard that ranks the player
+	Period      string      // Ranking period ID
+	Total       int64       // Number of players ranked on the leaderboard
+	Rank                    // Player's rank
+}
+
+type PlayerRankUpdate struct {
+	Leaderboard Leaderboard // Leaderboard whose ranking is updated
+	Period      string      // Ranking period ID
+	Value       float64     // Value that will be used to update the player's rank
+}
+
+// Records the rejected submissions of the leaderboards that keep them for review
+func recordUpdatesRejections(
+	ctx context.Context,
+	recordRejectionsFunc StorageRecordRejectionsFunc,
+	playerID string,
+	updates []PlayerRankUpdate,
+	errs []error,
+	caller Caller,
+) error {
+	errList := make([]error, 0)
+	for i, update := range updates {
+		if !errors.Is(errs[i], ErrSubmissionRejected) || !update.Leaderboard.SubmissionRules.RecordRejected {
+			continue
+		}
+
+		rejection := newRejection(update.Period, playerID, update.Value, caller, errs[i])
+		if err := recordRejectionsFunc(ctx, update.Leaderboard.ID, []Rejection{rejection}); err != nil {
+			errList = append(errList, fmt.Errorf("Leaderboard %s: %w", update.Leaderboard.ID, err))
+		}
+	}
+
+	return errors.Join(errList...)
+}
+
+// Joins the errors of the updates that failed, identifying their leaderboards
+func joinUpdatesErrors(updates []PlayerRankUpdate, errs []error) error {
+	errList := make([]error, 0)
+	for i, err := range errs {
+		if err != nil {
+			errList = append(errList, fmt.Errorf("Leaderboard %s: %w", updates[i].Leaderboard.ID, err))
+		}
+	}
+
+	return errors.Join(errList...)
+}
+
+func BuildUpsertPlayerLeaderboardsRanksFunc(
+	notifyRankChangedFunc NotifierPlayerRankChanged,
+	notifyDisplacedFunc NotifierPlayerDisplaced,
+	getLeaderboardsByIDsFunc StorageGetLeaderboardsByIDsFunc,
+	upsertPlayerLeaderboardsRankValuesFunc StorageUpsertPlayerLeaderboardsRankValuesFunc,
+	recordRejectionsFunc StorageRecordRejectionsFunc,
+) UpsertPlayerLeaderboardsRanksFunc {
+	return func(ctx context.Context, playerID string, values map[string]float64, caller Caller) error {
+		if playerID == "" {
+			return ErrInvalidPlayerID
+		}
+
+		if len(values) < MinLeaderboardsNumber || len(values) > MaxLeaderboardsNumber {
+			return ErrInvalidLeaderboardsNumber
+		}
+
+		// Sorted so the errors and the updates always follow the same order
+		ids := slices.Sorted(maps.Keys(values))
+
+		leaderboards, err := getLeaderboardsByIDsFunc(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[string]Leaderboard, len(leaderboards))
+		for _, lb := range leaderboards {
+			byID[lb.ID] = lb
+		}
+
+		var (
+			updates = make([]PlayerRankUpdate, 0, len(ids))
+			errList = make([]error, 0)
+		)
+		for _, id := range ids {
+			lb, ok := byID[id]
+			if !ok || lb.GameID != caller.GameID {
+				errList = append(errList, fmt.Errorf("Leaderboard %s: %w", id, ErrLeaderboardNotFound))
+				continue
+			}
+
+			if lb.Closed() {
+				errList = append(errList, fmt.Errorf("Leaderboard %s: %w", id, ErrLeaderboardClosed))
+				continue
+			}
+
+			updates = append(updates, PlayerRankUpdate{Leaderboard: lb, Period: lb.CurrentPeriod(), Value: values[id]})
+		}
+
+		if len(errList) > 0 {
+			return errors.Join(errList...)
+		}
+
+		errs := make([]error, len(updates))
+		for i, update := range updates {
+			errs[i] = update.Leaderboard.checkSubmission(update.Value)
+		}
+
+		var changes []RankChange
+		if err := errors.Join(errs...); err == nil {
+			changes, errs, err = upsertPlayerLeaderboardsRankValuesFunc(ctx, playerID, updates, caller)
+			if err != nil {
+				return err
+			}
+		}
+
+		// A single update that fails prevents every other one from being applied
+		if err := joinUpdatesErrors(updates, errs); err != nil {
+			return errors.Join(err, recordUpdatesRejections(ctx, recordRejectionsFunc, playerID, updates, errs, caller))
+		}
+
+		errList = make([]error, 0)
+		for i, update := range updates {
+			if err := notifyRankChange(ctx, update.Leaderboard, changes[i], notifyRankChangedFunc, notifyDisplacedFunc); err != nil {
+				errList = append(errList, fmt.Errorf("Leaderboard %s: %w", update.Leaderboard.ID, err))
+			}
+		}
+
+		return errors.Join(errList...)
+	}
+}
+
+func BuildPlayerLeaderboardsRanksFunc(
+	listGameLeaderboardsFunc StorageListGameLeaderboardsFunc,
+	getPlayerLeaderboardsRanksFunc StorageGetPlayerLeaderboardsRanksFunc,
+) PlayerLeaderboardsRanksFunc {
+	return func(ctx context.Context, gameID, playerID string) ([]LeaderboardRank, error) {
+		if playerID == "" {
+			return nil, ErrInvalidPlayerID
+		}
+
+		leaderboards, err := listGameLeaderboardsFunc(ctx, gameID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(leaderboards) == 0 {
+			return make([]LeaderboardRank, 0), nil
+		}
+
+		periods := make([]string, len(leaderboards))
+		for i, lb := range leaderboards {
+			periods[i] = lb.CurrentPeriod()
+		}
+
+		ranks, err := getPlayerLeaderboardsRanksFunc(ctx, playerID, leaderboards, periods)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range ranks {
+			placed := []Rank{ranks[i].Rank}
+			ranks[i].Leaderboard.placeRanks(placed, ranks[i].Total)
+			ranks[i].Rank = placed[0]
+		}
+
+		return ranks, nil
+	}
+}
@@ -0,0 +1,200 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildUpsertPlayerLeaderboardsRanksFunc(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		caller = Caller{GameID: uuid.NewString()}
+
+		weekly  = Leaderboard{ID: "a" + uuid.NewString(), GameID: caller.GameID}
+		allTime = Leaderboard{ID: "b" + uuid.NewString(), GameID: caller.GameID}
+	)
+
+	getLeaderboardsByIDsFunc := func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+		return []Leaderboard{allTime, weekly}, nil
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		var notified []string
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(
+			func(ctx context.Context, lb Leaderboard, change RankChange) error {
+				notified = append(notified, lb.ID)
+				return nil
+			},
+			notifyDisplacedFunc,
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				assert.Equal(t, []string{weekly.ID, allTime.ID}, ids)
+				return []Leaderboard{allTime, weekly}, nil
+			},
+			func(ctx context.Context, playerID string, updates []PlayerRankUpdate, c Caller) ([]RankChange, []error, error) {
+				assert.Equal(t, "player", playerID)
+				assert.Equal(t, caller, c)
+				assert.Len(t, updates, 2)
+				assert.Equal(t, weekly.ID, updates[0].Leaderboard.ID)
+				assert.Equal(t, float64(10), updates[0].Value)
+				assert.Equal(t, allTime.ID, updates[1].Leaderboard.ID)
+				assert.Equal(t, float64(20), updates[1].Value)
+				return make([]RankChange, 2), make([]error, 2), nil
+			},
+			nil,
+		)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10, allTime.ID: 20}, caller)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{weekly.ID, allTime.ID}, notified)
+	})
+
+	t.Run("Invalid Player ID", func(t *testing.T) {
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, nil, nil, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "", map[string]float64{weekly.ID: 10}, caller)
+		assert.ErrorIs(t, err, ErrInvalidPlayerID)
+	})
+
+	t.Run("Invalid Leaderboards Number", func(t *testing.T) {
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, nil, nil, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", nil, caller)
+		assert.ErrorIs(t, err, ErrInvalidLeaderboardsNumber)
+
+		values := make(map[string]float64)
+		for range MaxLeaderboardsNumber + 1 {
+			values[uuid.NewString()] = 1
+		}
+
+		err = upsertPlayerLeaderboardsRanksFunc(ctx, "player", values, caller)
+		assert.ErrorIs(t, err, ErrInvalidLeaderboardsNumber)
+	})
+
+	t.Run("Leaderboard From Another Game", func(t *testing.T) {
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, getLeaderboardsByIDsFunc, nil, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10}, Caller{GameID: uuid.NewString()})
+		assert.ErrorIs(t, err, ErrLeaderboardNotFound)
+		assert.ErrorContains(t, err, weekly.ID)
+	})
+
+	t.Run("Leaderboard Not Found", func(t *testing.T) {
+		id := uuid.NewString()
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, getLeaderboardsByIDsFunc, nil, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10, id: 20}, caller)
+		assert.ErrorIs(t, err, ErrLeaderboardNotFound)
+		assert.ErrorContains(t, err, id)
+	})
+
+	t.Run("Leaderboard Closed", func(t *testing.T) {
+		closed := Leaderboard{ID: uuid.NewString(), GameID: caller.GameID, EndAt: time.Now().Add(-time.Hour)}
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+			return []Leaderboard{weekly, closed}, nil
+		}, nil, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10, closed.ID: 20}, caller)
+		assert.ErrorIs(t, err, ErrLeaderboardClosed)
+		assert.ErrorContains(t, err, closed.ID)
+	})
+
+	t.Run("Submission Rejected", func(t *testing.T) {
+		var (
+			maxValue = float64(5)
+			bounded  = Leaderboard{ID: "c" + uuid.NewString(), GameID: caller.GameID, SubmissionRules: SubmissionRules{MaxValue: &maxValue, RecordRejected: true}}
+			recorded []string
+		)
+
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+			return []Leaderboard{weekly, bounded}, nil
+		}, nil, func(ctx context.Context, leaderboardID string, rejections []Rejection) error {
+			recorded = append(recorded, leaderboardID)
+			return nil
+		})
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10, bounded.ID: 20}, caller)
+		assert.ErrorIs(t, err, ErrValueOutOfBounds)
+		assert.ErrorContains(t, err, bounded.ID)
+		assert.Equal(t, []string{bounded.ID}, recorded)
+	})
+
+	t.Run("Update Failed", func(t *testing.T) {
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, getLeaderboardsByIDsFunc, func(ctx context.Context, playerID string, updates []PlayerRankUpdate, caller Caller) ([]RankChange, []error, error) {
+			return nil, []error{nil, ErrPlayerBanned}, nil
+		}, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10, allTime.ID: 20}, caller)
+		assert.ErrorIs(t, err, ErrPlayerBanned)
+		assert.ErrorContains(t, err, allTime.ID)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		upsertPlayerLeaderboardsRanksFunc := BuildUpsertPlayerLeaderboardsRanksFunc(nil, nil, getLeaderboardsByIDsFunc, func(ctx context.Context, playerID string, updates []PlayerRankUpdate, caller Caller) ([]RankChange, []error, error) {
+			return nil, nil, errors.New("any error")
+		}, nil)
+
+		err := upsertPlayerLeaderboardsRanksFunc(ctx, "player", map[string]float64{weekly.ID: 10}, caller)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPlayerLeaderboardsRanksFunc(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		gameID = uuid.NewString()
+
+		ranked = Leaderboard{ID: uuid.NewString(), GameID: gameID, Ordering: OrderingDesc, Tiers: []Tier{{Name: "Gold", Percentile: 10}}}
+		daily  = Leaderboard{ID: uuid.NewString(), GameID: gameID, Recurrence: RecurrenceDaily, Timezone: "UTC"}
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		playerLeaderboardsRanksFunc := BuildPlayerLeaderboardsRanksFunc(func(ctx context.Context, id string) ([]Leaderboard, error) {
+			assert.Equal(t, gameID, id)
+			return []Leaderboard{ranked, daily}, nil
+		}, func(ctx context.Context, playerID string, leaderboards []Leaderboard, periods []string) ([]LeaderboardRank, error) {
+			assert.Equal(t, "player", playerID)
+			assert.Equal(t, []Leaderboard{ranked, daily}, leaderboards)
+			assert.Equal(t, []string{ranked.CurrentPeriod(), daily.CurrentPeriod()}, periods)
+			return []LeaderboardRank{{Leaderboard: ranked, Total: 20, Rank: Rank{LeaderboardID: ranked.ID, PlayerID: playerID, Position: 1}}}, nil
+		})
+
+		ranks, err := playerLeaderboardsRanksFunc(ctx, gameID, "player")
+		assert.NoError(t, err)
+		assert.Len(t, ranks, 1)
+		assert.Equal(t, float64(10), ranks[0].Percentile)
+		assert.Equal(t, "Gold", ranks[0].Tier)
+	})
+
+	t.Run("No Leaderboards", func(t *testing.T) {
+		playerLeaderboardsRanksFunc := BuildPlayerLeaderboardsRanksFunc(func(ctx context.Context, gameID string) ([]Leaderboard, error) {
+			return []Leaderboard{}, nil
+		}, nil)
+
+		ranks, err := playerLeaderboardsRanksFunc(ctx, gameID, "player")
+		assert.NoError(t, err)
+		assert.Empty(t, ranks)
+	})
+
+	t.Run("Invalid Player ID", func(t *testing.T) {
+		playerLeaderboardsRanksFunc := BuildPlayerLeaderboardsRanksFunc(nil, nil)
+
+		_, err := playerLeaderboardsRanksFunc(ctx, gameID, "")
+		assert.ErrorIs(t, err, ErrInvalidPlayerID)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		playerLeaderboardsRanksFunc := BuildPlayerLeaderboardsRanksFunc(func(ctx context.Context, gameID string) ([]Leaderboard, error) {
+			return []Leaderboard{ranked}, nil
+		}, func(ctx context.Context, playerID string, leaderboards []Leaderboard, periods []string) ([]LeaderboardRank, error) {
+			return nil, errors.New("any error")
+		})
+
+		_, err := playerLeaderboardsRanksFunc(ctx, gameID, "player")
+		assert.Error(t, err)
+	})
+}
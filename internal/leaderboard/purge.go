@@ -0,0 +1,43 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const PurgeBatchSize = 100 // Deleted leaderboards purged per run
+
+func BuildPurgeDeletedFunc(
+	listDeletedFunc StorageListDeletedLeaderboardsFunc,
+	purgeResultsFunc StoragePurgeResultsFunc,
+	purgeRankingsFunc StoragePurgeRankingsFunc,
+	purgeFunc StoragePurgeLeaderboardFunc,
+) PurgeDeletedFunc {
+	return func(ctx context.Context, retention time.Duration) error {
+		leaderboards, err := listDeletedFunc(ctx, time.Now().Add(-retention), PurgeBatchSize)
+		if err != nil {
+			return err
+		}
+
+		errList := make([]error, 0)
+		for _, lb := range leaderboards {
+			// The leaderboard goes last, so it's listed again on the next run if anything fails
+			err := purgeResultsFunc(ctx, lb.ID)
+			if err == nil {
+				err = purgeRankingsFunc(ctx, lb.ID)
+			}
+
+			if err == nil {
+				err = purgeFunc(ctx, lb)
+			}
+
+			if err != nil {
+				errList = append(errList, fmt.Errorf("Leaderboard %s: %w", lb.ID, err))
+			}
+		}
+
+		return errors.Join(errList...)
+	}
+}
@@ -0,0 +1,117 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPurgeDeletedFunc(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		retention    = 30 * 24 * time.Hour
+		leaderboards = []Leaderboard{{ID: uuid.NewString()}, {ID: uuid.NewString()}}
+	)
+
+	listDeletedFunc := func(ctx context.Context, deletedBefore time.Time, limit int64) ([]Leaderboard, error) {
+		assert.WithinDuration(t, time.Now().Add(-retention), deletedBefore, time.Second)
+		assert.Equal(t, int64(PurgeBatchSize), limit)
+		return leaderboards, nil
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		var resultsPurged, rankingsPurged, purged []string
+		purgeDeletedFunc := BuildPurgeDeletedFunc(listDeletedFunc, func(ctx context.Context, leaderboardID string) error {
+			resultsPurged = append(resultsPurged, leaderboardID)
+			return nil
+		}, func(ctx context.Context, leaderboardID string) error {
+			rankingsPurged = append(rankingsPurged, leaderboardID)
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard) error {
+			purged = append(purged, leaderboard.ID)
+			return nil
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{leaderboards[0].ID, leaderboards[1].ID}, resultsPurged)
+		assert.Equal(t, []string{leaderboards[0].ID, leaderboards[1].ID}, rankingsPurged)
+		assert.Equal(t, []string{leaderboards[0].ID, leaderboards[1].ID}, purged)
+	})
+
+	t.Run("List Error", func(t *testing.T) {
+		purgeDeletedFunc := BuildPurgeDeletedFunc(func(ctx context.Context, deletedBefore time.Time, limit int64) ([]Leaderboard, error) {
+			return nil, errors.New("any error")
+		}, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard) error {
+			return nil
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Results Error", func(t *testing.T) {
+		var purged []string
+		purgeDeletedFunc := BuildPurgeDeletedFunc(listDeletedFunc, func(ctx context.Context, leaderboardID string) error {
+			if leaderboardID == leaderboards[0].ID {
+				return errors.New("any error")
+			}
+			return nil
+		}, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard) error {
+			purged = append(purged, leaderboard.ID)
+			return nil
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+
+		assert.ErrorContains(t, err, leaderboards[0].ID)
+		assert.Equal(t, []string{leaderboards[1].ID}, purged)
+	})
+
+	t.Run("Rankings Error", func(t *testing.T) {
+		var purged []string
+		purgeDeletedFunc := BuildPurgeDeletedFunc(listDeletedFunc, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboardID string) error {
+			if leaderboardID == leaderboards[1].ID {
+				return errors.New("any error")
+			}
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard) error {
+			purged = append(purged, leaderboard.ID)
+			return nil
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+
+		assert.ErrorContains(t, err, leaderboards[1].ID)
+		assert.Equal(t, []string{leaderboards[0].ID}, purged)
+	})
+
+	t.Run("Purge Error", func(t *testing.T) {
+		purgeDeletedFunc := BuildPurgeDeletedFunc(listDeletedFunc, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboardID string) error {
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard) error {
+			return errors.New("any error")
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+
+		assert.ErrorContains(t, err, leaderboards[0].ID)
+		assert.ErrorContains(t, err, leaderboards[1].ID)
+	})
+}
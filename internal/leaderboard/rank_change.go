@@ -0,0 +1,53 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidNotifyTop = errors.New("invalid notify top")
+
+const MaxNotifyTop = 100 // Largest top whose displaced players can be notified
+
+type RankChange struct {
+	Period           string  // Ranking period ID
+	PlayerID         string  // Player that made the submission
+	PreviousPosition *int64  // Player's position before the submission. Nil when the player wasn't ranked
+	Position         int64   // Player's position after the submission
+	Value            float64 // Player's value after the submission
+	Displaced        []Rank  // Players pushed out of the leaderboard top by the submission, with their new ranks
+}
+
+// Rolling leaderboards rank players from the merged buckets, so their positions aren't known when a submission is applied
+func (l NewLeaderboardData) validateNotifyTop() error {
+	if l.NotifyTop < 0 || l.NotifyTop > MaxNotifyTop || (l.NotifyTop > 0 && l.RollingWindow != 0) {
+		return ErrInvalidNotifyTop
+	}
+
+	return nil
+}
+
+// Notifies the player's new position and every player that the submission pushed out of the leaderboard top
+func notifyRankChange(
+	ctx context.Context,
+	lb Leaderboard,
+	change RankChange,
+	notifyRankChangedFunc NotifierPlayerRankChanged,
+	notifyDisplacedFunc NotifierPlayerDisplaced,
+) error {
+	if lb.Rolling() {
+		return nil
+	}
+
+	if err := notifyRankChangedFunc(ctx, lb, change); err != nil {
+		return err
+	}
+
+	for _, displaced := range change.Displaced {
+		if err := notifyDisplacedFunc(ctx, lb, change, displaced); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
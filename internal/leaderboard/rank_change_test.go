@@ -0,0 +1,137 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Notifiers that accept everything, used by the tests that don't check the notifications
+var (
+	notifyRankChangedFunc NotifierPlayerRankChanged = func(ctx context.Context, leaderboard Leaderboard, change RankChange) error {
+		return nil
+	}
+	notifyDisplacedFunc NotifierPlayerDisplaced = func(ctx context.Context, leaderboard Leaderboard, change RankChange, displaced Rank) error {
+		return nil
+	}
+)
+
+func TestValidateNotifyTop(t *testing.T) {
+	assert.NoError(t, NewLeaderboardData{}.validateNotifyTop())
+	assert.NoError(t, NewLeaderboardData{NotifyTop: MaxNotifyTop}.validateNotifyTop())
+
+	assert.ErrorIs(t, NewLeaderboardData{NotifyTop: -1}.validateNotifyTop(), ErrInvalidNotifyTop)
+	assert.ErrorIs(t, NewLeaderboardData{NotifyTop: MaxNotifyTop + 1}.validateNotifyTop(), ErrInvalidNotifyTop)
+	assert.ErrorIs(t, NewLeaderboardData{NotifyTop: 10, RollingWindow: time.Hour}.validateNotifyTop(), ErrInvalidNotifyTop)
+}
+
+func TestBuildUpsertPlayerRankFuncNotifications(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		lb       = Leaderboard{ID: uuid.NewString(), GameID: uuid.NewString(), NotifyTop: 3}
+		playerID = uuid.NewString()
+		previous = int64(5)
+		change   = RankChange{
+			PlayerID:         playerID,
+			PreviousPosition: &previous,
+			Position:         1,
+			Value:            100,
+			Displaced:        []Rank{{PlayerID: "a", Position: 3}, {PlayerID: "b", Position: 3}},
+		}
+	)
+
+	upsertFunc := func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error) {
+		return change, nil
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		var (
+			changed   RankChange
+			displaced = make([]string, 0)
+		)
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, c RankChange) error {
+			changed = c
+			return nil
+		}, func(ctx context.Context, leaderboard Leaderboard, c RankChange, rank Rank) error {
+			assert.Equal(t, playerID, c.PlayerID)
+			displaced = append(displaced, rank.PlayerID)
+			return nil
+		}, upsertFunc, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 100, nil, Caller{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, change, changed)
+		assert.Equal(t, []string{"a", "b"}, displaced)
+	})
+
+	t.Run("Rolling Leaderboard", func(t *testing.T) {
+		rolling := lb
+		rolling.RollingWindow = time.Hour
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, upsertFunc, nil)
+
+		err := upsertPlayerRankFunc(ctx, rolling, playerID, 100, nil, Caller{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not Applied", func(t *testing.T) {
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error) {
+			return RankChange{}, ErrPlayerBanned
+		}, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 100, nil, Caller{})
+		assert.ErrorIs(t, err, ErrPlayerBanned)
+	})
+
+	t.Run("Rank Changed Notifier Error", func(t *testing.T) {
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, c RankChange) error {
+			return errors.New("any error")
+		}, nil, upsertFunc, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 100, nil, Caller{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Displaced Notifier Error", func(t *testing.T) {
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(notifyRankChangedFunc, func(ctx context.Context, leaderboard Leaderboard, c RankChange, rank Rank) error {
+			return errors.New("any error")
+		}, upsertFunc, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 100, nil, Caller{})
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildUpsertPlayerRanksFuncNotifications(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		lb      = Leaderboard{ID: uuid.NewString()}
+		entries = []RankEntry{{PlayerID: "a", Value: 10}, {PlayerID: "b", Value: 20}, {PlayerID: "c", Value: 30}}
+	)
+
+	notified := make([]string, 0)
+	upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(func(ctx context.Context, leaderboard Leaderboard, change RankChange) error {
+		notified = append(notified, change.PlayerID)
+		if change.PlayerID == "c" {
+			return errors.New("any error")
+		}
+		return nil
+	}, notifyDisplacedFunc, func(ctx context.Context, lb Leaderboard, period string, entries []RankEntry, caller Caller) ([]RankChange, []error, error) {
+		return []RankChange{{PlayerID: "a"}, {}, {PlayerID: "c"}}, []error{nil, ErrPlayerBanned, nil}, nil
+	}, nil)
+
+	errs, err := upsertPlayerRanksFunc(ctx, lb, entries, Caller{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, notified)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrPlayerBanned)
+	assert.Error(t, errs[2])
+}
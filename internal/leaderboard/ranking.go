@@ -3,18 +3,33 @@ package leaderboard
 import (
 	"context"
 	"errors"
+	"slices"
 )
 
 var (
-	ErrLeaderboardClosed  = errors.New("leaderboard closed")
-	ErrInvalidPageNumber  = errors.New("invalid page number")
-	ErrInvalidLimitNumber = errors.New("invalid limit number")
+	ErrLeaderboardClosed    = errors.New("leaderboard closed")
+	ErrInvalidPageNumber    = errors.New("invalid page number")
+	ErrInvalidLimitNumber   = errors.New("invalid limit number")
+	ErrInvalidAroundNumber  = errors.New("invalid around number")
+	ErrPlayerRankNotFound   = errors.New("player rank not found")
+	ErrInvalidPlayersNumber = errors.New("invalid players number")
+	ErrInvalidEntriesNumber = errors.New("invalid entries number")
+	ErrInvalidPlayerID      = errors.New("invalid player id")
 )
 
 const (
 	MaxLimitNumber = 500
 	MinLimitNumber = 1
 	MinPageNumber  = 0
+
+	MaxAroundNumber = 50
+	MinAroundNumber = 0
+
+	MaxPlayersNumber = 100
+	MinPlayersNumber = 1
+
+	MaxEntriesNumber = 500
+	MinEntriesNumber = 1
 )
 
 type Rank struct {
@@ -22,28 +37,271 @@ type Rank struct {
 	PlayerID      string
 	Position      int64
 	Value         float64
+	Percentile    float64 // Top percentage of the ranked players that the player is in
+	Tier          string  // Name of the tier that the player is placed on. Empty when no tier places the player
+}
+
+type RankEntry struct {
+	PlayerID string  // Player's ID
+	Value    float64 // Value that will be used to update the player's rank
+	Scopes   []Scope // Scope partitions that will also rank the player
+}
+
+type Ranking struct {
+	Period string // Ranking period ID
+	Total  int64  // Number of players ranked on the leaderboard
+	Ranks  []Rank // Ranking page
+}
+
+type RelativeRank struct {
+	Rank                   // Player's rank, with its global position
+	RelativePosition int64 // Player's position among the players requested
+}
+
+type PlayersRanking struct {
+	Period string         // Ranking period ID
+	Total  int64          // Number of players ranked on the leaderboard
+	Ranks  []RelativeRank // Ranks of the players requested that are ranked, following the leaderboard ordering
 }
 
-func BuildUpsertPlayerRankFunc(upsertPlayerRankValueFunc StorageUpsertPlayerRankValueFunc) UpsertPlayerRankFunc {
-	return func(ctx context.Context, lb Leaderboard, playerID string, value float64) error {
-		if lb.Closed() {
+type PlayerRank struct {
+	Period string // Ranking period ID
+	Total  int64  // Number of players ranked on the leaderboard
+	Rank          // Player's rank
+	Above  []Rank // Players ranked right above the player, closest last
+	Below  []Rank // Players ranked right below the player, closest first
+}
+
+func BuildUpsertPlayerRankFunc(
+	notifyRankChangedFunc NotifierPlayerRankChanged,
+	notifyDisplacedFunc NotifierPlayerDisplaced,
+	upsertPlayerRankValueFunc StorageUpsertPlayerRankValueFunc,
+	recordRejectionsFunc StorageRecordRejectionsFunc,
+) UpsertPlayerRankFunc {
+	return func(ctx context.Context, lb Leaderboard, playerID string, value float64, scopes []Scope, caller Caller) error {
+		period := lb.CurrentPeriod()
+		if lb.PeriodClosed(period) {
 			return ErrLeaderboardClosed
 		}
 
-		return upsertPlayerRankValueFunc(ctx, lb, playerID, value)
+		if err := lb.validateScopes(scopes); err != nil {
+			return err
+		}
+
+		var change RankChange
+		err := lb.checkSubmission(value)
+		if err == nil {
+			change, err = upsertPlayerRankValueFunc(ctx, lb, period, playerID, value, scopes, caller)
+		}
+
+		if err == nil {
+			return notifyRankChange(ctx, lb, change, notifyRankChangedFunc, notifyDisplacedFunc)
+		}
+
+		if errors.Is(err, ErrSubmissionRejected) && lb.SubmissionRules.RecordRejected {
+			rejection := newRejection(period, playerID, value, caller, err)
+			if recordErr := recordRejectionsFunc(ctx, lb.ID, []Rejection{rejection}); recordErr != nil {
+				return errors.Join(err, recordErr)
+			}
+		}
+
+		return err
+	}
+}
+
+func BuildUpsertPlayerRanksFunc(
+	notifyRankChangedFunc NotifierPlayerRankChanged,
+	notifyDisplacedFunc NotifierPlayerDisplaced,
+	upsertPlayerRankValuesFunc StorageUpsertPlayerRankValuesFunc,
+	recordRejectionsFunc StorageRecordRejectionsFunc,
+) UpsertPlayerRanksFunc {
+	return func(ctx context.Context, lb Leaderboard, entries []RankEntry, caller Caller) ([]error, error) {
+		if len(entries) < MinEntriesNumber || len(entries) > MaxEntriesNumber {
+			return nil, ErrInvalidEntriesNumber
+		}
+
+		period := lb.CurrentPeriod()
+		if lb.PeriodClosed(period) {
+			return nil, ErrLeaderboardClosed
+		}
+
+		var (
+			errs    = make([]error, len(entries))
+			valid   = make([]RankEntry, 0, len(entries))
+			indexes = make([]int, 0, len(entries))
+		)
+		for i, entry := range entries {
+			if entry.PlayerID == "" {
+				errs[i] = ErrInvalidPlayerID
+				continue
+			}
+
+			if err := lb.validateScopes(entry.Scopes); err != nil {
+				errs[i] = err
+				continue
+			}
+
+			if err := lb.checkSubmission(entry.Value); err != nil {
+				errs[i] = err
+				continue
+			}
+
+			valid = append(valid, entry)
+			indexes = append(indexes, i)
+		}
+
+		if len(valid) > 0 {
+			changes, storageErrs, err := upsertPlayerRankValuesFunc(ctx, lb, period, valid, caller)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, err := range storageErrs {
+				if err == nil {
+					err = notifyRankChange(ctx, lb, changes[i], notifyRankChangedFunc, notifyDisplacedFunc)
+				}
+
+				errs[indexes[i]] = err
+			}
+		}
+
+		if !lb.SubmissionRules.RecordRejected {
+			return errs, nil
+		}
+
+		var (
+			rejections = make([]Rejection, 0)
+			rejected   = make([]int, 0)
+		)
+		for i, err := range errs {
+			if errors.Is(err, ErrSubmissionRejected) {
+				rejections = append(rejections, newRejection(period, entries[i].PlayerID, entries[i].Value, caller, err))
+				rejected = append(rejected, i)
+			}
+		}
+
+		if len(rejections) == 0 {
+			return errs, nil
+		}
+
+		if err := recordRejectionsFunc(ctx, lb.ID, rejections); err != nil {
+			for _, i := range rejected {
+				errs[i] = errors.Join(errs[i], err)
+			}
+		}
+
+		return errs, nil
+	}
+}
+
+func BuildRemovePlayerRankFunc(removePlayerRankFunc StorageRemovePlayerRankFunc) RemovePlayerRankFunc {
+	return func(ctx context.Context, lb Leaderboard, playerID string) error {
+		return removePlayerRankFunc(ctx, lb, lb.CurrentPeriod(), playerID)
 	}
 }
 
 func BuildRankingFunc(getRankingFunc StorageGetRankingFunc) RankingFunc {
-	return func(ctx context.Context, lb Leaderboard, page, limit int64) ([]Rank, error) {
+	return func(ctx context.Context, lb Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
 		if page < MinPageNumber {
-			return nil, ErrInvalidPageNumber
+			return Ranking{}, ErrInvalidPageNumber
 		}
 
 		if limit < MinLimitNumber || limit > MaxLimitNumber {
-			return nil, ErrInvalidLimitNumber
+			return Ranking{}, ErrInvalidLimitNumber
 		}
 
-		return getRankingFunc(ctx, lb.ID, lb.Ordering, page, limit)
+		period, err := lb.resolvePeriod(period)
+		if err != nil {
+			return Ranking{}, err
+		}
+
+		if err := lb.validateScope(scope); err != nil {
+			return Ranking{}, err
+		}
+
+		ranking, err := getRankingFunc(ctx, lb, period, scope, page, limit)
+		if err != nil {
+			return Ranking{}, err
+		}
+
+		lb.placeRanks(ranking.Ranks, ranking.Total)
+		return ranking, nil
 	}
 }
+
+func BuildPlayerRankFunc(getPlayerRankFunc StorageGetPlayerRankFunc) PlayerRankFunc {
+	return func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error) {
+		if around < MinAroundNumber || around > MaxAroundNumber {
+			return PlayerRank{}, ErrInvalidAroundNumber
+		}
+
+		period, err := lb.resolvePeriod(period)
+		if err != nil {
+			return PlayerRank{}, err
+		}
+
+		if err := lb.validateScope(scope); err != nil {
+			return PlayerRank{}, err
+		}
+
+		playerRank, err := getPlayerRankFunc(ctx, lb, period, scope, playerID, around)
+		if err != nil {
+			return PlayerRank{}, err
+		}
+
+		ranks := []Rank{playerRank.Rank}
+		lb.placeRanks(ranks, playerRank.Total)
+		lb.placeRanks(playerRank.Above, playerRank.Total)
+		lb.placeRanks(playerRank.Below, playerRank.Total)
+
+		playerRank.Rank = ranks[0]
+		return playerRank, nil
+	}
+}
+
+func BuildPlayersRankingFunc(getPlayersRankingFunc StorageGetPlayersRankingFunc) PlayersRankingFunc {
+	return func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerIDs []string) (PlayersRanking, error) {
+		playerIDs = slices.Compact(slices.Sorted(slices.Values(playerIDs)))
+		if len(playerIDs) < MinPlayersNumber || len(playerIDs) > MaxPlayersNumber {
+			return PlayersRanking{}, ErrInvalidPlayersNumber
+		}
+
+		period, err := lb.resolvePeriod(period)
+		if err != nil {
+			return PlayersRanking{}, err
+		}
+
+		if err := lb.validateScope(scope); err != nil {
+			return PlayersRanking{}, err
+		}
+
+		ranks, total, err := getPlayersRankingFunc(ctx, lb, period, scope, playerIDs)
+		if err != nil {
+			return PlayersRanking{}, err
+		}
+
+		lb.placeRanks(ranks, total)
+		return PlayersRanking{
+			Period: period,
+			Total:  total,
+			Ranks:  lb.relativeRanks(ranks),
+		}, nil
+	}
+}
+
+// Calculates the position of each rank among the ones provided, which must already follow the leaderboard ordering
+func (l Leaderboard) relativeRanks(ranks []Rank) []RelativeRank {
+	relativeRanks := make([]RelativeRank, len(ranks))
+	for i, rank := range ranks {
+		position := int64(i)
+		if i > 0 && l.RankingMode != RankingModeOrdinal && rank.Value == ranks[i-1].Value {
+			position = relativeRanks[i-1].RelativePosition
+		} else if i > 0 && l.RankingMode == RankingModeDense {
+			position = relativeRanks[i-1].RelativePosition + 1
+		}
+
+		relativeRanks[i] = RelativeRank{Rank: rank, RelativePosition: position}
+	}
+
+	return relativeRanks
+}
@@ -27,11 +27,12 @@ func TestBuildUpsertPlayerRankFunc(t *testing.T) {
 			AggregationMode: AggregationModeInc,
 		}
 
-		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, playerID string, value float64) error {
-			return nil
-		})
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(notifyRankChangedFunc, notifyDisplacedFunc, func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error) {
+			assert.Equal(t, Caller{GameID: gameID, Client: "127.0.0.1"}, caller)
+			return RankChange{}, nil
+		}, nil)
 
-		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64())
+		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), nil, Caller{GameID: gameID, Client: "127.0.0.1"})
 		assert.NoError(t, err)
 	})
 
@@ -42,22 +43,49 @@ func TestBuildUpsertPlayerRankFunc(t *testing.T) {
 			AggregationMode: "INVALID",
 		}
 
-		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, playerID string, value float64) error {
-			return ErrInvalidAggregationMode
-		})
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(notifyRankChangedFunc, notifyDisplacedFunc, func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error) {
+			return RankChange{}, ErrInvalidAggregationMode
+		}, nil)
 
-		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64())
+		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), nil, Caller{})
 		assert.ErrorIs(t, err, ErrInvalidAggregationMode)
 	})
 
 	t.Run("Leaderboard Closed", func(t *testing.T) {
 		lb := Leaderboard{EndAt: time.Now().Add(-24 * time.Hour)}
 
-		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil)
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, nil, nil)
 
-		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64())
+		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), nil, Caller{})
 		assert.ErrorIs(t, err, ErrLeaderboardClosed)
 	})
+
+	t.Run("Scoped", func(t *testing.T) {
+		var (
+			lb     = Leaderboard{ID: leaderboardID, Scopes: []string{"region", "platform"}}
+			scopes = []Scope{{Dimension: "region", Value: "eu"}, {Dimension: "platform", Value: "pc"}}
+		)
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(notifyRankChangedFunc, notifyDisplacedFunc, func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, s []Scope, caller Caller) (RankChange, error) {
+			assert.Equal(t, scopes, s)
+			return RankChange{}, nil
+		}, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), scopes, Caller{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Scope", func(t *testing.T) {
+		lb := Leaderboard{ID: leaderboardID, Scopes: []string{"region"}}
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, nil, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), []Scope{{Dimension: "platform", Value: "pc"}}, Caller{})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+
+		err = upsertPlayerRankFunc(ctx, lb, playerID, rand.Float64(), []Scope{{Dimension: "region", Value: "eu"}, {Dimension: "region", Value: "na"}}, Caller{})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
 }
 
 func TestBuildRankingFunc(t *testing.T) {
@@ -69,11 +97,11 @@ func TestBuildRankingFunc(t *testing.T) {
 			Ordering: OrderingAsc,
 		}
 
-		rankingFunc := BuildRankingFunc(func(ctx context.Context, leaderboardID, ordering string, page, limit int64) ([]Rank, error) {
-			return make([]Rank, 0), nil
+		rankingFunc := BuildRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+			return Ranking{Ranks: make([]Rank, 0)}, nil
 		})
 
-		_, err := rankingFunc(ctx, lb, 0, 10)
+		_, err := rankingFunc(ctx, lb, "", Scope{}, 0, 10)
 		assert.NoError(t, err)
 	})
 
@@ -85,7 +113,7 @@ func TestBuildRankingFunc(t *testing.T) {
 
 		rankingFunc := BuildRankingFunc(nil)
 
-		_, err := rankingFunc(ctx, lb, MinPageNumber-1, 10)
+		_, err := rankingFunc(ctx, lb, "", Scope{}, MinPageNumber-1, 10)
 		assert.ErrorIs(t, err, ErrInvalidPageNumber)
 	})
 
@@ -97,7 +125,7 @@ func TestBuildRankingFunc(t *testing.T) {
 
 		rankingFunc := BuildRankingFunc(nil)
 
-		_, err := rankingFunc(ctx, lb, 0, MinLimitNumber-1)
+		_, err := rankingFunc(ctx, lb, "", Scope{}, 0, MinLimitNumber-1)
 		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
 	})
 
@@ -109,21 +137,62 @@ func TestBuildRankingFunc(t *testing.T) {
 
 		rankingFunc := BuildRankingFunc(nil)
 
-		_, err := rankingFunc(ctx, lb, 0, MaxLimitNumber+1)
+		_, err := rankingFunc(ctx, lb, "", Scope{}, 0, MaxLimitNumber+1)
 		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
 	})
 
+	t.Run("Current Period", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:         uuid.NewString(),
+			Ordering:   OrderingAsc,
+			Recurrence: RecurrenceDaily,
+		}
+
+		rankingFunc := BuildRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+			return Ranking{Period: period, Ranks: make([]Rank, 0)}, nil
+		})
+
+		ranking, err := rankingFunc(ctx, lb, "", Scope{}, 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Now().UTC().Format("2006-01-02"), ranking.Period)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:         uuid.NewString(),
+			Ordering:   OrderingAsc,
+			Recurrence: RecurrenceDaily,
+		}
+
+		rankingFunc := BuildRankingFunc(nil)
+
+		_, err := rankingFunc(ctx, lb, "INVALID", Scope{}, 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+		_, err = rankingFunc(ctx, lb, time.Now().UTC().AddDate(0, 0, 2).Format("2006-01-02"), Scope{}, 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("Invalid Scope", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Scopes: []string{"region"}}
+
+		rankingFunc := BuildRankingFunc(nil)
+
+		_, err := rankingFunc(ctx, lb, "", Scope{Dimension: "platform", Value: "pc"}, 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+
 	t.Run("Invalid Ordering Value", func(t *testing.T) {
 		lb := Leaderboard{
 			ID:       uuid.NewString(),
 			Ordering: "INVALID",
 		}
 
-		rankingFunc := BuildRankingFunc(func(ctx context.Context, leaderboardID, ordering string, page, limit int64) ([]Rank, error) {
-			return nil, ErrInvalidOrdering
+		rankingFunc := BuildRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+			return Ranking{}, ErrInvalidOrdering
 		})
 
-		_, err := rankingFunc(ctx, lb, 0, 10)
+		_, err := rankingFunc(ctx, lb, "", Scope{}, 0, 10)
 		assert.ErrorIs(t, err, ErrInvalidOrdering)
 	})
 
@@ -133,11 +202,250 @@ func TestBuildRankingFunc(t *testing.T) {
 			Ordering: OrderingAsc,
 		}
 
-		rankingFunc := BuildRankingFunc(func(ctx context.Context, leaderboardID, ordering string, page, limit int64) ([]Rank, error) {
-			return nil, errors.New("any error")
+		rankingFunc := BuildRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+			return Ranking{}, errors.New("any error")
+		})
+
+		_, err := rankingFunc(ctx, lb, "", Scope{}, 0, 10)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPlayerRankFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		playerID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:       uuid.NewString(),
+			Ordering: OrderingDesc,
+		}
+
+		playerRankFunc := BuildPlayerRankFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error) {
+			return PlayerRank{Rank: Rank{LeaderboardID: lb.ID, PlayerID: playerID}}, nil
+		})
+
+		playerRank, err := playerRankFunc(ctx, lb, "", Scope{}, playerID, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, playerID, playerRank.PlayerID)
+	})
+
+	t.Run("Around Number Lower Than Minimun", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:       uuid.NewString(),
+			Ordering: OrderingDesc,
+		}
+
+		playerRankFunc := BuildPlayerRankFunc(nil)
+
+		_, err := playerRankFunc(ctx, lb, "", Scope{}, playerID, MinAroundNumber-1)
+		assert.ErrorIs(t, err, ErrInvalidAroundNumber)
+	})
+
+	t.Run("Around Number Greater Than Maximum", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:       uuid.NewString(),
+			Ordering: OrderingDesc,
+		}
+
+		playerRankFunc := BuildPlayerRankFunc(nil)
+
+		_, err := playerRankFunc(ctx, lb, "", Scope{}, playerID, MaxAroundNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidAroundNumber)
+	})
+
+	t.Run("Player Rank Not Found", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:       uuid.NewString(),
+			Ordering: OrderingDesc,
+		}
+
+		playerRankFunc := BuildPlayerRankFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error) {
+			return PlayerRank{}, ErrPlayerRankNotFound
+		})
+
+		_, err := playerRankFunc(ctx, lb, "", Scope{}, playerID, 5)
+		assert.ErrorIs(t, err, ErrPlayerRankNotFound)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:       uuid.NewString(),
+			Ordering: OrderingDesc,
+		}
+
+		playerRankFunc := BuildPlayerRankFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error) {
+			return PlayerRank{}, errors.New("any error")
+		})
+
+		_, err := playerRankFunc(ctx, lb, "", Scope{}, playerID, 5)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPlayersRankingFunc(t *testing.T) {
+	ctx := context.Background()
+
+	ranks := []Rank{
+		{PlayerID: "a", Position: 3, Value: 30},
+		{PlayerID: "b", Position: 7, Value: 20},
+		{PlayerID: "c", Position: 7, Value: 20},
+		{PlayerID: "d", Position: 12, Value: 10},
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Ordering: OrderingDesc, RankingMode: RankingModeStandard}
+
+		playersRankingFunc := BuildPlayersRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerIDs []string) ([]Rank, int64, error) {
+			assert.Equal(t, []string{"a", "b", "c", "d"}, playerIDs)
+			return ranks, 20, nil
 		})
 
-		_, err := rankingFunc(ctx, lb, 0, 10)
+		ranking, err := playersRankingFunc(ctx, lb, "", Scope{}, []string{"d", "c", "b", "a", "a"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), ranking.Total)
+		assert.Len(t, ranking.Ranks, 4)
+
+		positions := make([]int64, len(ranking.Ranks))
+		for i, rank := range ranking.Ranks {
+			positions[i] = rank.RelativePosition
+		}
+		assert.Equal(t, []int64{0, 1, 1, 3}, positions)
+		assert.Equal(t, int64(7), ranking.Ranks[2].Position)
+	})
+
+	t.Run("Dense Ranking Mode", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Ordering: OrderingDesc, RankingMode: RankingModeDense}
+
+		positions := make([]int64, len(ranks))
+		for i, rank := range lb.relativeRanks(ranks) {
+			positions[i] = rank.RelativePosition
+		}
+		assert.Equal(t, []int64{0, 1, 1, 2}, positions)
+	})
+
+	t.Run("Ordinal Ranking Mode", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Ordering: OrderingDesc, RankingMode: RankingModeOrdinal}
+
+		positions := make([]int64, len(ranks))
+		for i, rank := range lb.relativeRanks(ranks) {
+			positions[i] = rank.RelativePosition
+		}
+		assert.Equal(t, []int64{0, 1, 2, 3}, positions)
+	})
+
+	t.Run("Invalid Players Number", func(t *testing.T) {
+		playersRankingFunc := BuildPlayersRankingFunc(nil)
+
+		_, err := playersRankingFunc(ctx, Leaderboard{}, "", Scope{}, nil)
+		assert.ErrorIs(t, err, ErrInvalidPlayersNumber)
+
+		playerIDs := make([]string, MaxPlayersNumber+1)
+		for i := range playerIDs {
+			playerIDs[i] = uuid.NewString()
+		}
+
+		_, err = playersRankingFunc(ctx, Leaderboard{}, "", Scope{}, playerIDs)
+		assert.ErrorIs(t, err, ErrInvalidPlayersNumber)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		playersRankingFunc := BuildPlayersRankingFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerIDs []string) ([]Rank, int64, error) {
+			return nil, 0, errors.New("any error")
+		})
+
+		_, err := playersRankingFunc(ctx, Leaderboard{}, "", Scope{}, []string{"a"})
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildUpsertPlayerRanksFunc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		var (
+			lb      = Leaderboard{ID: uuid.NewString(), Scopes: []string{"region"}}
+			entries = []RankEntry{
+				{PlayerID: "a", Value: 10, Scopes: []Scope{{Dimension: "region", Value: "eu"}}},
+				{PlayerID: "", Value: 20},
+				{PlayerID: "c", Value: 30, Scopes: []Scope{{Dimension: "platform", Value: "pc"}}},
+				{PlayerID: "d", Value: 40},
+			}
+		)
+
+		upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(notifyRankChangedFunc, notifyDisplacedFunc, func(ctx context.Context, lb Leaderboard, period string, entries []RankEntry, caller Caller) ([]RankChange, []error, error) {
+			assert.Len(t, entries, 2)
+			assert.Equal(t, "a", entries[0].PlayerID)
+			assert.Equal(t, "d", entries[1].PlayerID)
+			return make([]RankChange, 2), []error{nil, errors.New("any error")}, nil
+		}, nil)
+
+		errs, err := upsertPlayerRanksFunc(ctx, lb, entries, Caller{})
+		assert.NoError(t, err)
+		assert.Len(t, errs, 4)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], ErrInvalidPlayerID)
+		assert.ErrorIs(t, errs[2], ErrInvalidScope)
+		assert.Error(t, errs[3])
+	})
+
+	t.Run("Invalid Entries Number", func(t *testing.T) {
+		upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(nil, nil, nil, nil)
+
+		_, err := upsertPlayerRanksFunc(ctx, Leaderboard{}, nil, Caller{})
+		assert.ErrorIs(t, err, ErrInvalidEntriesNumber)
+
+		_, err = upsertPlayerRanksFunc(ctx, Leaderboard{}, make([]RankEntry, MaxEntriesNumber+1), Caller{})
+		assert.ErrorIs(t, err, ErrInvalidEntriesNumber)
+	})
+
+	t.Run("Leaderboard Closed", func(t *testing.T) {
+		upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(nil, nil, nil, nil)
+
+		_, err := upsertPlayerRanksFunc(ctx, Leaderboard{EndAt: time.Now().Add(-time.Hour)}, []RankEntry{{PlayerID: "a"}}, Caller{})
+		assert.ErrorIs(t, err, ErrLeaderboardClosed)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(notifyRankChangedFunc, notifyDisplacedFunc, func(ctx context.Context, lb Leaderboard, period string, entries []RankEntry, caller Caller) ([]RankChange, []error, error) {
+			return nil, nil, errors.New("any error")
+		}, nil)
+
+		_, err := upsertPlayerRanksFunc(ctx, Leaderboard{}, []RankEntry{{PlayerID: "a"}}, Caller{})
 		assert.Error(t, err)
 	})
 }
+
+func TestBuildRemovePlayerRankFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+		playerID      = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{ID: leaderboardID, Recurrence: RecurrenceDaily, Timezone: "UTC"}
+
+		removePlayerRankFunc := BuildRemovePlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, period, id string) error {
+			assert.Equal(t, lb.CurrentPeriod(), period)
+			assert.Equal(t, playerID, id)
+			return nil
+		})
+
+		err := removePlayerRankFunc(ctx, lb, playerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Player Rank Not Found", func(t *testing.T) {
+		removePlayerRankFunc := BuildRemovePlayerRankFunc(func(ctx context.Context, leaderboard Leaderboard, period, playerID string) error {
+			return ErrPlayerRankNotFound
+		})
+
+		err := removePlayerRankFunc(ctx, Leaderboard{ID: leaderboardID}, playerID)
+		assert.ErrorIs(t, err, ErrPlayerRankNotFound)
+	})
+}
@@ -0,0 +1,221 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrResultsNotFound        = errors.New("results not found")
+	ErrFinalizationNotClaimed = errors.New("finalization not claimed")
+)
+
+const (
+	FinalizedTopNumber    = 10  // Number of ranks sent on the leaderboard finalized notification
+	FinalizationBatchSize = 100 // Max number of finalizations claimed on each run
+)
+
+type Results struct {
+	LeaderboardID string    // Leaderboard's ID
+	Period        string    // Ranking period ID
+	FinalizedAt   time.Time // Time that the ranking was frozen
+	Total         int64     // Number of players ranked on the leaderboard
+	Ranks         []Rank    // Ranking page
+}
+
+type Finalization struct {
+	Leaderboard Leaderboard // Leaderboard that owns the period
+	Period      string      // ID of the period to be finalized
+}
+
+// Copies the whole period ranking to the results storage, page by page, storing the rewards earned along the way.
+// The claim is renewed after each page, so large rankings are not taken over by another worker halfway through
+func snapshotRanking(
+	ctx context.Context,
+	f Finalization,
+	renewFinalizationFunc StorageRenewFinalizationFunc,
+	getRankingFunc StorageGetRankingFunc,
+	appendResultsFunc StorageAppendResultsFunc,
+	saveRewardsFunc StorageSaveRewardsFunc,
+	saveResultsFunc StorageSaveResultsFunc,
+) (Results, []Reward, error) {
+	var (
+		lb      = f.Leaderboard
+		period  = f.Period
+		results = Results{
+			LeaderboardID: lb.ID,
+			Period:        period,
+			Ranks:         make([]Rank, 0),
+		}
+		rewards = make([]Reward, 0)
+	)
+
+	for page := int64(0); ; page++ {
+		ranking, err := getRankingFunc(ctx, lb, period, Scope{}, page, MaxLimitNumber)
+		if err != nil {
+			return Results{}, nil, err
+		}
+
+		if page == 0 {
+			results.Total = ranking.Total
+			results.Ranks = ranking.Ranks[:min(len(ranking.Ranks), FinalizedTopNumber)]
+		}
+
+		if len(ranking.Ranks) == 0 {
+			break
+		}
+
+		if err := appendResultsFunc(ctx, lb.ID, period, page*MaxLimitNumber, ranking.Ranks); err != nil {
+			return Results{}, nil, err
+		}
+
+		pageRewards := make([]Reward, 0)
+		for _, rank := range ranking.Ranks {
+			if reward, ok := lb.reward(period, rank, results.Total); ok {
+				pageRewards = append(pageRewards, reward)
+			}
+		}
+
+		if len(pageRewards) > 0 {
+			if err := saveRewardsFunc(ctx, pageRewards); err != nil {
+				return Results{}, nil, err
+			}
+
+			rewards = append(rewards, pageRewards...)
+		}
+
+		if len(ranking.Ranks) < MaxLimitNumber {
+			break
+		}
+
+		if err := renewFinalizationFunc(ctx, f); err != nil {
+			return Results{}, nil, err
+		}
+	}
+
+	results.FinalizedAt = time.Now()
+	if err := saveResultsFunc(ctx, results); err != nil {
+		return Results{}, nil, err
+	}
+
+	return results, rewards, nil
+}
+
+func BuildFinalizeDueFunc(
+	notifyFinalizedFunc NotifierLeaderboardFinalized,
+	notifyRewardFunc NotifierPlayerRewarded,
+	claimDueFinalizationsFunc StorageClaimDueFinalizationsFunc,
+	renewFinalizationFunc StorageRenewFinalizationFunc,
+	getLeaderboardsByIDsFunc StorageGetLeaderboardsByIDsFunc,
+	scheduleFinalizationFunc StorageScheduleFinalizationFunc,
+	completeFinalizationFunc StorageCompleteFinalizationFunc,
+	getRankingFunc StorageGetRankingFunc,
+	appendResultsFunc StorageAppendResultsFunc,
+	saveRewardsFunc StorageSaveRewardsFunc,
+	saveResultsFunc StorageSaveResultsFunc,
+) FinalizeDueFunc {
+	finalize := func(ctx context.Context, f Finalization) error {
+		lb := f.Leaderboard
+
+		// Deleted leaderboards have nothing to be finalized
+		if lb.DeletedAt.IsZero() {
+			results, rewards, err := snapshotRanking(ctx, f, renewFinalizationFunc, getRankingFunc, appendResultsFunc, saveRewardsFunc, saveResultsFunc)
+			if err != nil {
+				return err
+			}
+
+			if err := notifyFinalizedFunc(ctx, lb, results); err != nil {
+				return err
+			}
+
+			for _, reward := range rewards {
+				if err := notifyRewardFunc(ctx, lb, reward); err != nil {
+					return err
+				}
+			}
+
+			if next := lb.nextPeriod(f.Period); next != "" {
+				if err := scheduleFinalizationFunc(ctx, Finalization{Leaderboard: lb, Period: next}, lb.PeriodEnd(next)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return completeFinalizationFunc(ctx, f)
+	}
+
+	return func(ctx context.Context) error {
+		finalizations, err := claimDueFinalizationsFunc(ctx, time.Now(), FinalizationBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(finalizations) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(finalizations))
+		for i, f := range finalizations {
+			ids[i] = f.Leaderboard.ID
+		}
+
+		leaderboards, err := getLeaderboardsByIDsFunc(ctx, slices.Compact(slices.Sorted(slices.Values(ids))))
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[string]Leaderboard, len(leaderboards))
+		for _, lb := range leaderboards {
+			byID[lb.ID] = lb
+		}
+
+		errList := make([]error, 0)
+		for _, f := range finalizations {
+			lb, ok := byID[f.Leaderboard.ID]
+			if ok {
+				f.Leaderboard = lb
+				err = finalize(ctx, f)
+			} else {
+				// The leaderboard no longer exists, so there's nothing to finalize
+				err = completeFinalizationFunc(ctx, f)
+			}
+
+			if err != nil {
+				errList = append(errList, fmt.Errorf("Leaderboard %s Period '%s': %w", f.Leaderboard.ID, f.Period, err))
+			}
+		}
+
+		return errors.Join(errList...)
+	}
+}
+
+func BuildResultsFunc(getResultsFunc StorageGetResultsFunc) ResultsFunc {
+	return func(ctx context.Context, lb Leaderboard, period string, page, limit int64) (Results, error) {
+		if page < MinPageNumber {
+			return Results{}, ErrInvalidPageNumber
+		}
+
+		if limit < MinLimitNumber || limit > MaxLimitNumber {
+			return Results{}, ErrInvalidLimitNumber
+		}
+
+		if period == "" {
+			period = lb.previousPeriod()
+		}
+
+		if _, _, err := lb.PeriodBounds(period); err != nil {
+			return Results{}, err
+		}
+
+		results, err := getResultsFunc(ctx, lb.ID, period, page, limit)
+		if err != nil {
+			return Results{}, err
+		}
+
+		lb.placeRanks(results.Ranks, results.Total)
+		return results, nil
+	}
+}
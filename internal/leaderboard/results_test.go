@@ -0,0 +1,384 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildRanks(leaderboardID string, offset, size int) []Rank {
+	ranks := make([]Rank, size)
+	for i := range ranks {
+		ranks[i] = Rank{
+			LeaderboardID: leaderboardID,
+			PlayerID:      fmt.Sprintf("player-%d", offset+i),
+			Position:      int64(offset + i),
+			Value:         float64(size - i),
+		}
+	}
+
+	return ranks
+}
+
+func TestBuildFinalizeDueFunc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:         uuid.NewString(),
+				GameID:     uuid.NewString(),
+				StartAt:    time.Now().AddDate(0, 0, -7),
+				Recurrence: RecurrenceDaily,
+				RewardTiers: []RewardTier{
+					{Name: "Champion", FromPosition: 0, ToPosition: 0},
+					{Name: "Top 1%", Percentile: 1},
+				},
+			}
+			period = lb.PeriodAt(time.Now().AddDate(0, 0, -1))
+
+			notified  Results
+			saved     Results
+			scheduled Finalization
+			completed Finalization
+			appended  int
+			renewed   int
+			rewarded  []Reward
+			stored    []Reward
+		)
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			func(ctx context.Context, leaderboard Leaderboard, results Results) error {
+				notified = results
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, reward Reward) error {
+				rewarded = append(rewarded, reward)
+				return nil
+			},
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: lb.ID}, Period: period}}, nil
+			},
+			func(ctx context.Context, finalization Finalization) error {
+				assert.Equal(t, period, finalization.Period)
+				renewed++
+				return nil
+			},
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				assert.Equal(t, []string{lb.ID}, ids)
+				return []Leaderboard{lb}, nil
+			},
+			func(ctx context.Context, finalization Finalization, at time.Time) error {
+				scheduled = finalization
+				assert.Equal(t, lb.PeriodEnd(finalization.Period), at)
+				return nil
+			},
+			func(ctx context.Context, finalization Finalization) error {
+				completed = finalization
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, p string, scope Scope, page, limit int64) (Ranking, error) {
+				assert.Equal(t, period, p)
+
+				switch page {
+				case 0:
+					return Ranking{Period: p, Total: MaxLimitNumber + 1, Ranks: buildRanks(lb.ID, 0, MaxLimitNumber)}, nil
+				case 1:
+					return Ranking{Period: p, Total: MaxLimitNumber + 1, Ranks: buildRanks(lb.ID, MaxLimitNumber, 1)}, nil
+				default:
+					return Ranking{Period: p, Total: MaxLimitNumber + 1, Ranks: make([]Rank, 0)}, nil
+				}
+			},
+			func(ctx context.Context, leaderboardID, p string, offset int64, ranks []Rank) error {
+				assert.Equal(t, int64(appended), offset)
+				appended += len(ranks)
+				return nil
+			},
+			func(ctx context.Context, rewards []Reward) error {
+				stored = append(stored, rewards...)
+				return nil
+			},
+			func(ctx context.Context, results Results) error {
+				saved = results
+				return nil
+			},
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, MaxLimitNumber+1, appended)
+		assert.Equal(t, 1, renewed)
+		assert.Equal(t, int64(MaxLimitNumber+1), saved.Total)
+		assert.False(t, saved.FinalizedAt.IsZero())
+		assert.Len(t, notified.Ranks, FinalizedTopNumber)
+		assert.Equal(t, period, notified.Period)
+		assert.Equal(t, lb.CurrentPeriod(), scheduled.Period)
+		assert.Equal(t, period, completed.Period)
+
+		// 1% of 501 players awards the positions 0 to 5, but the first one is taken by the previous tier
+		assert.Len(t, stored, 6)
+		assert.Equal(t, stored, rewarded)
+		assert.Equal(t, "Champion", rewarded[0].Tier)
+		assert.Equal(t, "player-0", rewarded[0].PlayerID)
+		assert.Equal(t, "Top 1%", rewarded[5].Tier)
+		assert.Equal(t, int64(5), rewarded[5].Position)
+	})
+
+	t.Run("Last Period", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:      uuid.NewString(),
+				GameID:  uuid.NewString(),
+				StartAt: time.Now().AddDate(0, 0, -7),
+				EndAt:   time.Now().Add(-time.Hour),
+			}
+
+			completed bool
+		)
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			func(ctx context.Context, leaderboard Leaderboard, results Results) error {
+				assert.Empty(t, results.Ranks)
+				return nil
+			},
+			nil,
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: lb.ID}}}, nil
+			},
+			nil,
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				assert.Equal(t, []string{lb.ID}, ids)
+				return []Leaderboard{lb}, nil
+			},
+			nil,
+			func(ctx context.Context, finalization Finalization) error {
+				completed = true
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+				return Ranking{Ranks: make([]Rank, 0)}, nil
+			},
+			nil,
+			nil,
+			func(ctx context.Context, results Results) error {
+				return nil
+			},
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("Deleted Leaderboard", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:        uuid.NewString(),
+				DeletedAt: time.Now(),
+				EndAt:     time.Now().Add(-time.Hour),
+			}
+
+			completed bool
+		)
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			nil,
+			nil,
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: lb.ID}}}, nil
+			},
+			nil,
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				assert.Equal(t, []string{lb.ID}, ids)
+				return []Leaderboard{lb}, nil
+			},
+			nil,
+			func(ctx context.Context, finalization Finalization) error {
+				completed = true
+				return nil
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("Claim Lost", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), EndAt: time.Now().Add(-time.Hour)}
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			func(ctx context.Context, leaderboard Leaderboard, results Results) error {
+				t.Error("finalization must not be notified")
+				return nil
+			},
+			nil,
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: lb.ID}}}, nil
+			},
+			func(ctx context.Context, finalization Finalization) error {
+				return ErrFinalizationNotClaimed
+			},
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				return []Leaderboard{lb}, nil
+			},
+			nil,
+			func(ctx context.Context, finalization Finalization) error {
+				t.Error("finalization must not be completed")
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+				return Ranking{Ranks: buildRanks(lb.ID, int(page*MaxLimitNumber), MaxLimitNumber)}, nil
+			},
+			func(ctx context.Context, leaderboardID, period string, offset int64, ranks []Rank) error {
+				return nil
+			},
+			nil,
+			nil,
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.ErrorIs(t, err, ErrFinalizationNotClaimed)
+	})
+
+	t.Run("Claim Error", func(t *testing.T) {
+		finalizeDueFunc := BuildFinalizeDueFunc(nil, nil, func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+			return nil, errors.New("any error")
+		}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		err := finalizeDueFunc(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Leaderboard Not Found", func(t *testing.T) {
+		var (
+			id        = uuid.NewString()
+			completed bool
+		)
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			nil,
+			nil,
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: id}}}, nil
+			},
+			nil,
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				return make([]Leaderboard, 0), nil
+			},
+			nil,
+			func(ctx context.Context, finalization Finalization) error {
+				assert.Equal(t, id, finalization.Leaderboard.ID)
+				completed = true
+				return nil
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("Get Leaderboards Error", func(t *testing.T) {
+		finalizeDueFunc := BuildFinalizeDueFunc(nil, nil, func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+			return []Finalization{{Leaderboard: Leaderboard{ID: uuid.NewString()}}}, nil
+		}, nil, func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+			return nil, errors.New("any error")
+		}, nil, nil, nil, nil, nil, nil)
+
+		err := finalizeDueFunc(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), EndAt: time.Now().Add(-time.Hour)}
+
+		finalizeDueFunc := BuildFinalizeDueFunc(
+			nil,
+			nil,
+			func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error) {
+				return []Finalization{{Leaderboard: Leaderboard{ID: lb.ID}}}, nil
+			},
+			nil,
+			func(ctx context.Context, ids []string) ([]Leaderboard, error) {
+				assert.Equal(t, []string{lb.ID}, ids)
+				return []Leaderboard{lb}, nil
+			},
+			nil,
+			func(ctx context.Context, finalization Finalization) error {
+				t.Error("finalization must not be completed")
+				return nil
+			},
+			func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error) {
+				return Ranking{}, errors.New("any error")
+			},
+			nil,
+			nil,
+			nil,
+		)
+
+		err := finalizeDueFunc(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildResultsFunc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Recurrence: RecurrenceMonthly}
+
+		resultsFunc := BuildResultsFunc(func(ctx context.Context, leaderboardID, period string, page, limit int64) (Results, error) {
+			return Results{LeaderboardID: leaderboardID, Period: period, Ranks: make([]Rank, 0)}, nil
+		})
+
+		results, err := resultsFunc(ctx, lb, "", 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, lb.ID, results.LeaderboardID)
+		assert.Equal(t, time.Now().UTC().AddDate(0, 0, -time.Now().UTC().Day()).Format("2006-01"), results.Period)
+	})
+
+	t.Run("Page Number Lower Than Minimun", func(t *testing.T) {
+		resultsFunc := BuildResultsFunc(nil)
+
+		_, err := resultsFunc(ctx, Leaderboard{}, "", MinPageNumber-1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPageNumber)
+	})
+
+	t.Run("Limit Number Greater Than Maximum", func(t *testing.T) {
+		resultsFunc := BuildResultsFunc(nil)
+
+		_, err := resultsFunc(ctx, Leaderboard{}, "", 0, MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		resultsFunc := BuildResultsFunc(nil)
+
+		_, err := resultsFunc(ctx, Leaderboard{Recurrence: RecurrenceWeekly}, "2024-01", 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("Results Not Found", func(t *testing.T) {
+		resultsFunc := BuildResultsFunc(func(ctx context.Context, leaderboardID, period string, page, limit int64) (Results, error) {
+			return Results{}, ErrResultsNotFound
+		})
+
+		_, err := resultsFunc(ctx, Leaderboard{}, "", 0, 10)
+		assert.ErrorIs(t, err, ErrResultsNotFound)
+	})
+}
@@ -0,0 +1,94 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrInvalidRewardTier = errors.New("invalid reward tier")
+	ErrRewardNotFound    = errors.New("reward not found")
+)
+
+const MaxRewardTiers = 20
+
+type RewardTier struct {
+	Name         string  // Tier's name, unique on the leaderboard
+	FromPosition int64   // First ranking position awarded by the tier. Ignored on percentile tiers
+	ToPosition   int64   // Last ranking position awarded by the tier. Ignored on percentile tiers
+	Percentile   float64 // Top percentage of the ranked players awarded by the tier. Zero for rank range tiers
+}
+
+type Reward struct {
+	LeaderboardID string  // Leaderboard's ID
+	Period        string  // Ranking period ID
+	PlayerID      string  // Player's ID
+	Position      int64   // Player's final ranking position
+	Value         float64 // Player's final rank value
+	Tier          string  // Name of the tier earned by the player
+}
+
+func validateRewardTiers(tiers []RewardTier) error {
+	if len(tiers) > MaxRewardTiers {
+		return ErrInvalidRewardTier
+	}
+
+	names := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" || names[tier.Name] {
+			return ErrInvalidRewardTier
+		}
+		names[tier.Name] = true
+
+		if tier.Percentile < 0 || tier.Percentile > 100 {
+			return ErrInvalidRewardTier
+		}
+
+		if tier.Percentile == 0 && (tier.FromPosition < 0 || tier.ToPosition < tier.FromPosition) {
+			return ErrInvalidRewardTier
+		}
+	}
+
+	return nil
+}
+
+// Checks if the rank is awarded by the tier
+func (t RewardTier) awards(rank Rank, total int64) bool {
+	if t.Percentile > 0 {
+		return float64(rank.Position) < float64(total)*t.Percentile/100
+	}
+
+	return rank.Position >= t.FromPosition && rank.Position <= t.ToPosition
+}
+
+// Returns the reward earned by the rank. Tiers are checked in order, so the first one that awards the rank wins
+func (l Leaderboard) reward(period string, rank Rank, total int64) (Reward, bool) {
+	for _, tier := range l.RewardTiers {
+		if tier.awards(rank, total) {
+			return Reward{
+				LeaderboardID: l.ID,
+				Period:        period,
+				PlayerID:      rank.PlayerID,
+				Position:      rank.Position,
+				Value:         rank.Value,
+				Tier:          tier.Name,
+			}, true
+		}
+	}
+
+	return Reward{}, false
+}
+
+func BuildPlayerRewardFunc(getPlayerRewardFunc StorageGetPlayerRewardFunc) PlayerRewardFunc {
+	return func(ctx context.Context, lb Leaderboard, period, playerID string) (Reward, error) {
+		if period == "" {
+			period = lb.previousPeriod()
+		}
+
+		if _, _, err := lb.PeriodBounds(period); err != nil {
+			return Reward{}, err
+		}
+
+		return getPlayerRewardFunc(ctx, lb.ID, period, playerID)
+	}
+}
@@ -0,0 +1,114 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRewardTiers(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		err := validateRewardTiers([]RewardTier{
+			{Name: "Champion", FromPosition: 0, ToPosition: 0},
+			{Name: "Podium", FromPosition: 1, ToPosition: 9},
+			{Name: "Top 5%", Percentile: 5},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Empty Name", func(t *testing.T) {
+		assert.ErrorIs(t, validateRewardTiers([]RewardTier{{FromPosition: 0, ToPosition: 0}}), ErrInvalidRewardTier)
+	})
+
+	t.Run("Duplicated Name", func(t *testing.T) {
+		err := validateRewardTiers([]RewardTier{
+			{Name: "Champion", FromPosition: 0, ToPosition: 0},
+			{Name: "Champion", FromPosition: 1, ToPosition: 1},
+		})
+		assert.ErrorIs(t, err, ErrInvalidRewardTier)
+	})
+
+	t.Run("Invalid Range", func(t *testing.T) {
+		assert.ErrorIs(t, validateRewardTiers([]RewardTier{{Name: "Podium", FromPosition: 9, ToPosition: 1}}), ErrInvalidRewardTier)
+		assert.ErrorIs(t, validateRewardTiers([]RewardTier{{Name: "Podium", FromPosition: -1, ToPosition: 1}}), ErrInvalidRewardTier)
+	})
+
+	t.Run("Invalid Percentile", func(t *testing.T) {
+		assert.ErrorIs(t, validateRewardTiers([]RewardTier{{Name: "Top", Percentile: 101}}), ErrInvalidRewardTier)
+		assert.ErrorIs(t, validateRewardTiers([]RewardTier{{Name: "Top", Percentile: -1}}), ErrInvalidRewardTier)
+	})
+
+	t.Run("Too Many Tiers", func(t *testing.T) {
+		tiers := make([]RewardTier, MaxRewardTiers+1)
+		for i := range tiers {
+			tiers[i] = RewardTier{Name: uuid.NewString(), FromPosition: int64(i), ToPosition: int64(i)}
+		}
+
+		assert.ErrorIs(t, validateRewardTiers(tiers), ErrInvalidRewardTier)
+	})
+}
+
+func TestLeaderboardReward(t *testing.T) {
+	lb := Leaderboard{
+		ID: uuid.NewString(),
+		RewardTiers: []RewardTier{
+			{Name: "Champion", FromPosition: 0, ToPosition: 0},
+			{Name: "Top 10%", Percentile: 10},
+		},
+	}
+
+	t.Run("Rank Range", func(t *testing.T) {
+		reward, ok := lb.reward("", Rank{PlayerID: "player", Position: 0, Value: 100}, 100)
+		assert.True(t, ok)
+		assert.Equal(t, "Champion", reward.Tier)
+		assert.Equal(t, "player", reward.PlayerID)
+		assert.Equal(t, lb.ID, reward.LeaderboardID)
+	})
+
+	t.Run("Percentile", func(t *testing.T) {
+		reward, ok := lb.reward("", Rank{PlayerID: "player", Position: 9}, 100)
+		assert.True(t, ok)
+		assert.Equal(t, "Top 10%", reward.Tier)
+	})
+
+	t.Run("Not Awarded", func(t *testing.T) {
+		_, ok := lb.reward("", Rank{PlayerID: "player", Position: 10}, 100)
+		assert.False(t, ok)
+	})
+}
+
+func TestBuildPlayerRewardFunc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		lb := Leaderboard{ID: uuid.NewString(), Recurrence: RecurrenceDaily}
+
+		playerRewardFunc := BuildPlayerRewardFunc(func(ctx context.Context, leaderboardID, period, playerID string) (Reward, error) {
+			return Reward{LeaderboardID: leaderboardID, Period: period, PlayerID: playerID, Tier: "Champion"}, nil
+		})
+
+		reward, err := playerRewardFunc(ctx, lb, "", "player")
+		assert.NoError(t, err)
+		assert.Equal(t, "Champion", reward.Tier)
+		assert.Equal(t, lb.PeriodAt(time.Now().AddDate(0, 0, -1)), reward.Period)
+	})
+
+	t.Run("Invalid Period", func(t *testing.T) {
+		playerRewardFunc := BuildPlayerRewardFunc(nil)
+
+		_, err := playerRewardFunc(ctx, Leaderboard{Recurrence: RecurrenceMonthly}, "2024-01-01", "player")
+		assert.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+
+	t.Run("Reward Not Found", func(t *testing.T) {
+		playerRewardFunc := BuildPlayerRewardFunc(func(ctx context.Context, leaderboardID, period, playerID string) (Reward, error) {
+			return Reward{}, ErrRewardNotFound
+		})
+
+		_, err := playerRewardFunc(ctx, Leaderboard{}, "", "player")
+		assert.ErrorIs(t, err, ErrRewardNotFound)
+	})
+}
@@ -0,0 +1,93 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidSubmissionRules = errors.New("invalid submission rules")
+
+	ErrSubmissionRejected = errors.New("submission rejected")
+	ErrValueOutOfBounds   = fmt.Errorf("%w: value out of bounds", ErrSubmissionRejected)
+	ErrIncreaseTooHigh    = fmt.Errorf("%w: increase too high", ErrSubmissionRejected)
+	ErrTooManySubmissions = fmt.Errorf("%w: too many submissions", ErrSubmissionRejected)
+)
+
+type SubmissionRules struct {
+	MinValue       *float64      // Lowest value accepted per submission. Nil when there's no lower bound
+	MaxValue       *float64      // Highest value accepted per submission. Nil when there's no upper bound
+	MaxIncrease    float64       // Highest value a single submission can add to the player's rank. INC leaderboards only, zero when there's no limit
+	MaxSubmissions int64         // Submissions accepted per player on each rate window. Zero when there's no limit
+	RateWindow     time.Duration // Window used to limit the player's submissions
+	RecordRejected bool          // Whether the rejected submissions are recorded for review
+}
+
+type Rejection struct {
+	ID         string    // Rejection ID, used as the pagination cursor
+	PlayerID   string    // Player's ID
+	Period     string    // Ranking period ID that would receive the submission
+	Value      float64   // Value submitted
+	Reason     string    // Why the submission was rejected
+	Caller               // Who made the submission
+	RejectedAt time.Time // Time that the submission was rejected
+}
+
+type Rejections struct {
+	Rejections []Rejection // Rejections page, most recent first
+	NextCursor string      // Cursor of the next page. Empty on the last one
+}
+
+func (r SubmissionRules) validate(aggregationMode string) error {
+	if r.MinValue != nil && r.MaxValue != nil && *r.MinValue > *r.MaxValue {
+		return ErrInvalidSubmissionRules
+	}
+
+	if r.MaxIncrease < 0 || (r.MaxIncrease > 0 && aggregationMode != AggregationModeInc) {
+		return ErrInvalidSubmissionRules
+	}
+
+	if r.MaxSubmissions < 0 || r.RateWindow < 0 || (r.MaxSubmissions > 0) != (r.RateWindow >= time.Millisecond) {
+		return ErrInvalidSubmissionRules
+	}
+
+	return nil
+}
+
+// Checks the bounds of a single submission. The submission rate is checked by the storage, when the value is applied
+func (l Leaderboard) checkSubmission(value float64) error {
+	rules := l.SubmissionRules
+
+	if (rules.MinValue != nil && value < *rules.MinValue) || (rules.MaxValue != nil && value > *rules.MaxValue) {
+		return ErrValueOutOfBounds
+	}
+
+	if l.AggregationMode == AggregationModeInc && rules.MaxIncrease > 0 && value > rules.MaxIncrease {
+		return ErrIncreaseTooHigh
+	}
+
+	return nil
+}
+
+func newRejection(period, playerID string, value float64, caller Caller, err error) Rejection {
+	return Rejection{
+		PlayerID:   playerID,
+		Period:     period,
+		Value:      value,
+		Reason:     err.Error(),
+		Caller:     caller,
+		RejectedAt: time.Now(),
+	}
+}
+
+func BuildRejectionsFunc(getRejectionsFunc StorageGetRejectionsFunc) RejectionsFunc {
+	return func(ctx context.Context, lb Leaderboard, cursor string, limit int64) (Rejections, error) {
+		if limit < MinLimitNumber || limit > MaxLimitNumber {
+			return Rejections{}, ErrInvalidLimitNumber
+		}
+
+		return getRejectionsFunc(ctx, lb.ID, cursor, limit)
+	}
+}
@@ -0,0 +1,235 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissionRulesValidate(t *testing.T) {
+	var (
+		minValue = float64(0)
+		maxValue = float64(1000)
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		rules := SubmissionRules{
+			MinValue:       &minValue,
+			MaxValue:       &maxValue,
+			MaxIncrease:    100,
+			MaxSubmissions: 10,
+			RateWindow:     time.Minute,
+			RecordRejected: true,
+		}
+
+		assert.NoError(t, rules.validate(AggregationModeInc))
+		assert.NoError(t, SubmissionRules{}.validate(AggregationModeMax))
+	})
+
+	t.Run("Inverted Bounds", func(t *testing.T) {
+		rules := SubmissionRules{MinValue: &maxValue, MaxValue: &minValue}
+		assert.ErrorIs(t, rules.validate(AggregationModeMax), ErrInvalidSubmissionRules)
+	})
+
+	t.Run("Max Increase", func(t *testing.T) {
+		assert.ErrorIs(t, SubmissionRules{MaxIncrease: 10}.validate(AggregationModeMax), ErrInvalidSubmissionRules)
+		assert.ErrorIs(t, SubmissionRules{MaxIncrease: -1}.validate(AggregationModeInc), ErrInvalidSubmissionRules)
+	})
+
+	t.Run("Rate Limit", func(t *testing.T) {
+		assert.ErrorIs(t, SubmissionRules{MaxSubmissions: 10}.validate(AggregationModeMax), ErrInvalidSubmissionRules)
+		assert.ErrorIs(t, SubmissionRules{RateWindow: time.Minute}.validate(AggregationModeMax), ErrInvalidSubmissionRules)
+		assert.ErrorIs(t, SubmissionRules{MaxSubmissions: -1, RateWindow: time.Minute}.validate(AggregationModeMax), ErrInvalidSubmissionRules)
+	})
+}
+
+func TestLeaderboardCheckSubmission(t *testing.T) {
+	var (
+		minValue = float64(0)
+		maxValue = float64(1000)
+
+		lb = Leaderboard{
+			AggregationMode: AggregationModeInc,
+			SubmissionRules: SubmissionRules{MinValue: &minValue, MaxValue: &maxValue, MaxIncrease: 100},
+		}
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		assert.NoError(t, lb.checkSubmission(0))
+		assert.NoError(t, lb.checkSubmission(100))
+		assert.NoError(t, Leaderboard{AggregationMode: AggregationModeMax}.checkSubmission(-1e12))
+	})
+
+	t.Run("Value Out Of Bounds", func(t *testing.T) {
+		assert.ErrorIs(t, lb.checkSubmission(-1), ErrValueOutOfBounds)
+		assert.ErrorIs(t, lb.checkSubmission(1e12), ErrValueOutOfBounds)
+		assert.ErrorIs(t, lb.checkSubmission(-1), ErrSubmissionRejected)
+	})
+
+	t.Run("Increase Too High", func(t *testing.T) {
+		assert.ErrorIs(t, lb.checkSubmission(101), ErrIncreaseTooHigh)
+	})
+}
+
+func TestBuildUpsertPlayerRankFuncRejections(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		maxValue = float64(1000)
+		playerID = uuid.NewString()
+		caller   = Caller{GameID: uuid.NewString(), Client: "127.0.0.1"}
+	)
+
+	t.Run("Recorded", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:              uuid.NewString(),
+				AggregationMode: AggregationModeMax,
+				SubmissionRules: SubmissionRules{MaxValue: &maxValue, RecordRejected: true},
+			}
+			recorded []Rejection
+		)
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, nil, func(ctx context.Context, leaderboardID string, rejections []Rejection) error {
+			assert.Equal(t, lb.ID, leaderboardID)
+			recorded = rejections
+			return nil
+		})
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 1e12, nil, caller)
+		assert.ErrorIs(t, err, ErrValueOutOfBounds)
+
+		assert.Len(t, recorded, 1)
+		assert.Equal(t, playerID, recorded[0].PlayerID)
+		assert.Equal(t, float64(1e12), recorded[0].Value)
+		assert.Equal(t, caller, recorded[0].Caller)
+		assert.Equal(t, ErrValueOutOfBounds.Error(), recorded[0].Reason)
+	})
+
+	t.Run("Rate Limited", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:              uuid.NewString(),
+				AggregationMode: AggregationModeMax,
+				SubmissionRules: SubmissionRules{MaxSubmissions: 1, RateWindow: time.Second, RecordRejected: true},
+			}
+			recorded []Rejection
+		)
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(
+			notifyRankChangedFunc,
+			notifyDisplacedFunc,
+			func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error) {
+				return RankChange{}, ErrTooManySubmissions
+			},
+			func(ctx context.Context, leaderboardID string, rejections []Rejection) error {
+				recorded = rejections
+				return nil
+			},
+		)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 10, nil, caller)
+		assert.ErrorIs(t, err, ErrTooManySubmissions)
+		assert.Len(t, recorded, 1)
+	})
+
+	t.Run("Not Recorded", func(t *testing.T) {
+		lb := Leaderboard{
+			ID:              uuid.NewString(),
+			AggregationMode: AggregationModeMax,
+			SubmissionRules: SubmissionRules{MaxValue: &maxValue},
+		}
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, nil, nil)
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 1e12, nil, caller)
+		assert.ErrorIs(t, err, ErrValueOutOfBounds)
+	})
+
+	t.Run("Record Error", func(t *testing.T) {
+		var (
+			lb = Leaderboard{
+				ID:              uuid.NewString(),
+				AggregationMode: AggregationModeMax,
+				SubmissionRules: SubmissionRules{MaxValue: &maxValue, RecordRejected: true},
+			}
+			errRandom = errors.New("random error")
+		)
+
+		upsertPlayerRankFunc := BuildUpsertPlayerRankFunc(nil, nil, nil, func(ctx context.Context, leaderboardID string, rejections []Rejection) error {
+			return errRandom
+		})
+
+		err := upsertPlayerRankFunc(ctx, lb, playerID, 1e12, nil, caller)
+		assert.ErrorIs(t, err, ErrValueOutOfBounds)
+		assert.ErrorIs(t, err, errRandom)
+	})
+}
+
+func TestBuildUpsertPlayerRanksFuncRejections(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		maxValue = float64(1000)
+		caller   = Caller{GameID: uuid.NewString()}
+		lb       = Leaderboard{
+			ID:              uuid.NewString(),
+			AggregationMode: AggregationModeMax,
+			SubmissionRules: SubmissionRules{MaxValue: &maxValue, MaxSubmissions: 1, RateWindow: time.Second, RecordRejected: true},
+		}
+	)
+
+	var recorded []Rejection
+	upsertPlayerRanksFunc := BuildUpsertPlayerRanksFunc(
+		notifyRankChangedFunc,
+		notifyDisplacedFunc,
+		func(ctx context.Context, leaderboard Leaderboard, period string, entries []RankEntry, caller Caller) ([]RankChange, []error, error) {
+			assert.Equal(t, []RankEntry{{PlayerID: "a", Value: 10}, {PlayerID: "a", Value: 20}}, entries)
+			return make([]RankChange, 2), []error{nil, ErrTooManySubmissions}, nil
+		},
+		func(ctx context.Context, leaderboardID string, rejections []Rejection) error {
+			recorded = rejections
+			return nil
+		},
+	)
+
+	errs, err := upsertPlayerRanksFunc(ctx, lb, []RankEntry{{PlayerID: "a", Value: 10}, {PlayerID: "b", Value: 1e12}, {PlayerID: "a", Value: 20}}, caller)
+	assert.NoError(t, err)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrValueOutOfBounds)
+	assert.ErrorIs(t, errs[2], ErrTooManySubmissions)
+
+	assert.Len(t, recorded, 2)
+	assert.Equal(t, "b", recorded[0].PlayerID)
+	assert.Equal(t, "a", recorded[1].PlayerID)
+}
+
+func TestBuildRejectionsFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		leaderboardID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		rejectionsFunc := BuildRejectionsFunc(func(ctx context.Context, id, cursor string, limit int64) (Rejections, error) {
+			assert.Equal(t, leaderboardID, id)
+			return Rejections{Rejections: []Rejection{{ID: "0-1"}}}, nil
+		})
+
+		rejections, err := rejectionsFunc(ctx, Leaderboard{ID: leaderboardID}, "", 10)
+		assert.NoError(t, err)
+		assert.Len(t, rejections.Rejections, 1)
+	})
+
+	t.Run("Invalid Limit Number", func(t *testing.T) {
+		rejectionsFunc := BuildRejectionsFunc(nil)
+
+		_, err := rejectionsFunc(ctx, Leaderboard{ID: leaderboardID}, "", MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+}
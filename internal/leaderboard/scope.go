@@ -0,0 +1,83 @@
+package leaderboard
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidScope = errors.New("invalid scope")
+
+const (
+	MaxScopes           = 5
+	MaxScopeValueLength = 64
+)
+
+type Scope struct {
+	Dimension string // Partition dimension, one of the leaderboard's scopes. Empty for the global ranking
+	Value     string // Partition value
+}
+
+// Parses a scope formatted as `{dimension}:{value}`. An empty string is the global ranking
+func ParseScope(s string) (Scope, error) {
+	if s == "" {
+		return Scope{}, nil
+	}
+
+	dimension, value, ok := strings.Cut(s, ":")
+	if !ok || dimension == "" || value == "" {
+		return Scope{}, ErrInvalidScope
+	}
+
+	return Scope{Dimension: dimension, Value: value}, nil
+}
+
+func (s Scope) Global() bool {
+	return s.Dimension == ""
+}
+
+func validateScopeDimensions(dimensions []string) error {
+	if len(dimensions) > MaxScopes {
+		return ErrInvalidScope
+	}
+
+	seen := make(map[string]bool, len(dimensions))
+	for _, dimension := range dimensions {
+		if dimension == "" || strings.Contains(dimension, ":") || seen[dimension] {
+			return ErrInvalidScope
+		}
+		seen[dimension] = true
+	}
+
+	return nil
+}
+
+// Checks if the scope is the global ranking or a valid partition of the leaderboard
+func (l Leaderboard) validateScope(scope Scope) error {
+	if scope.Global() {
+		return nil
+	}
+
+	if !slices.Contains(l.Scopes, scope.Dimension) || scope.Value == "" || len(scope.Value) > MaxScopeValueLength {
+		return ErrInvalidScope
+	}
+
+	return nil
+}
+
+// Checks if the scopes are valid partitions of the leaderboard with at most one value per dimension
+func (l Leaderboard) validateScopes(scopes []Scope) error {
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		if scope.Global() || seen[scope.Dimension] {
+			return ErrInvalidScope
+		}
+		seen[scope.Dimension] = true
+
+		if err := l.validateScope(scope); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
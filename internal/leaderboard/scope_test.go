@@ -0,0 +1,59 @@
+package leaderboard
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScope(t *testing.T) {
+	t.Run("Global", func(t *testing.T) {
+		scope, err := ParseScope("")
+		assert.NoError(t, err)
+		assert.True(t, scope.Global())
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		scope, err := ParseScope("region:eu")
+		assert.NoError(t, err)
+		assert.Equal(t, Scope{Dimension: "region", Value: "eu"}, scope)
+	})
+
+	t.Run("Invalid Scope", func(t *testing.T) {
+		for _, s := range []string{"region", "region:", ":eu"} {
+			_, err := ParseScope(s)
+			assert.ErrorIs(t, err, ErrInvalidScope)
+		}
+	})
+}
+
+func TestValidateScopeDimensions(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		assert.NoError(t, validateScopeDimensions([]string{"region", "platform"}))
+	})
+
+	t.Run("Invalid Dimension", func(t *testing.T) {
+		assert.ErrorIs(t, validateScopeDimensions([]string{""}), ErrInvalidScope)
+		assert.ErrorIs(t, validateScopeDimensions([]string{"region:eu"}), ErrInvalidScope)
+		assert.ErrorIs(t, validateScopeDimensions([]string{"region", "region"}), ErrInvalidScope)
+	})
+
+	t.Run("Too Many Dimensions", func(t *testing.T) {
+		dimensions := make([]string, MaxScopes+1)
+		for i := range dimensions {
+			dimensions[i] = strings.Repeat("d", i+1)
+		}
+
+		assert.ErrorIs(t, validateScopeDimensions(dimensions), ErrInvalidScope)
+	})
+}
+
+func TestLeaderboardValidateScope(t *testing.T) {
+	lb := Leaderboard{Scopes: []string{"region"}}
+
+	assert.NoError(t, lb.validateScope(Scope{}))
+	assert.NoError(t, lb.validateScope(Scope{Dimension: "region", Value: "eu"}))
+	assert.ErrorIs(t, lb.validateScope(Scope{Dimension: "platform", Value: "pc"}), ErrInvalidScope)
+	assert.ErrorIs(t, lb.validateScope(Scope{Dimension: "region", Value: strings.Repeat("a", MaxScopeValueLength+1)}), ErrInvalidScope)
+}
@@ -0,0 +1,76 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrInvalidStatisticBinding = errors.New("invalid statistic binding")
+	ErrStatisticNotFound       = errors.New("statistic not found")
+)
+
+// Aggregation modes that can be fed by a statistic. The statistic already aggregates the player's submissions,
+// so the leaderboard receives the player's current value instead of the value submitted
+var StatisticAggregationModes = []string{
+	AggregationModeLast,
+	AggregationModeMax,
+	AggregationModeMin,
+}
+
+func (l NewLeaderboardData) validateStatisticBinding() error {
+	if l.StatisticID != "" && !slices.Contains(StatisticAggregationModes, l.AggregationMode) {
+		return ErrInvalidStatisticBinding
+	}
+
+	return nil
+}
+
+// Checks that the statistic bound to the leaderboard exists on the leaderboard's game, otherwise the ranking would never be fed
+func (l NewLeaderboardData) checkStatistic(ctx context.Context, statisticExistsFunc StorageStatisticExistsFunc) error {
+	if l.StatisticID == "" {
+		return nil
+	}
+
+	exists, err := statisticExistsFunc(ctx, l.GameID, l.StatisticID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.Join(ErrStatisticNotFound, ErrValidationError)
+	}
+
+	return nil
+}
+
+// Feeds every leaderboard bound to the statistic that still accepts submissions. Submissions rejected by the leaderboard
+// rules, or made by banned players, don't fail the statistic update
+func BuildUpsertStatisticPlayerRanksFunc(
+	listByStatisticFunc StorageListLeaderboardsByStatisticFunc,
+	upsertPlayerRankFunc UpsertPlayerRankFunc,
+) UpsertStatisticPlayerRanksFunc {
+	return func(ctx context.Context, gameID, statisticID, playerID string, value float64) error {
+		leaderboards, err := listByStatisticFunc(ctx, gameID, statisticID)
+		if err != nil {
+			return err
+		}
+
+		errList := make([]error, 0)
+		for _, lb := range leaderboards {
+			// Deleted leaderboards are closed as well
+			if lb.Closed() {
+				continue
+			}
+
+			err := upsertPlayerRankFunc(ctx, lb, playerID, value, nil, Caller{GameID: gameID})
+			if err != nil && !errors.Is(err, ErrSubmissionRejected) && !errors.Is(err, ErrPlayerBanned) {
+				errList = append(errList, fmt.Errorf("Leaderboard %s: %w", lb.ID, err))
+			}
+		}
+
+		return errors.Join(errList...)
+	}
+}
@@ -0,0 +1,117 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStatisticBinding(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		for _, mode := range StatisticAggregationModes {
+			assert.NoError(t, NewLeaderboardData{AggregationMode: mode, StatisticID: uuid.NewString()}.validateStatisticBinding())
+		}
+
+		assert.NoError(t, NewLeaderboardData{AggregationMode: AggregationModeInc}.validateStatisticBinding())
+	})
+
+	t.Run("Invalid Aggregation Mode", func(t *testing.T) {
+		for _, mode := range []string{AggregationModeInc, AggregationModeAvg, AggregationModeCount} {
+			err := NewLeaderboardData{AggregationMode: mode, StatisticID: uuid.NewString()}.validateStatisticBinding()
+			assert.ErrorIs(t, err, ErrInvalidStatisticBinding)
+		}
+	})
+}
+
+func TestBuildUpsertStatisticPlayerRanksFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		gameID      = uuid.NewString()
+		statisticID = uuid.NewString()
+		playerID    = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		var (
+			active  = Leaderboard{ID: uuid.NewString(), StartAt: time.Now().Add(-time.Hour)}
+			ended   = Leaderboard{ID: uuid.NewString(), StartAt: time.Now().Add(-time.Hour), EndAt: time.Now().Add(-time.Minute)}
+			deleted = Leaderboard{ID: uuid.NewString(), StartAt: time.Now().Add(-time.Hour), DeletedAt: time.Now()}
+			updated = make([]string, 0)
+		)
+
+		upsertStatisticPlayerRanksFunc := BuildUpsertStatisticPlayerRanksFunc(
+			func(ctx context.Context, gID, sID string) ([]Leaderboard, error) {
+				assert.Equal(t, gameID, gID)
+				assert.Equal(t, statisticID, sID)
+				return []Leaderboard{active, ended, deleted}, nil
+			},
+			func(ctx context.Context, lb Leaderboard, pID string, value float64, scopes []Scope, caller Caller) error {
+				assert.Equal(t, playerID, pID)
+				assert.Equal(t, float64(42), value)
+				assert.Equal(t, gameID, caller.GameID)
+				updated = append(updated, lb.ID)
+				return nil
+			},
+		)
+
+		err := upsertStatisticPlayerRanksFunc(ctx, gameID, statisticID, playerID, 42)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{active.ID}, updated)
+	})
+
+	t.Run("Submission Rejected", func(t *testing.T) {
+		upsertStatisticPlayerRanksFunc := BuildUpsertStatisticPlayerRanksFunc(
+			func(ctx context.Context, gameID, statisticID string) ([]Leaderboard, error) {
+				return []Leaderboard{{ID: uuid.NewString()}, {ID: uuid.NewString()}}, nil
+			},
+			func(ctx context.Context, lb Leaderboard, playerID string, value float64, scopes []Scope, caller Caller) error {
+				return errors.Join(ErrValueOutOfBounds, ErrPlayerBanned)
+			},
+		)
+
+		err := upsertStatisticPlayerRanksFunc(ctx, gameID, statisticID, playerID, 42)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Upsert Player Rank Error", func(t *testing.T) {
+		var (
+			failed = Leaderboard{ID: uuid.NewString()}
+			calls  = 0
+		)
+
+		upsertStatisticPlayerRanksFunc := BuildUpsertStatisticPlayerRanksFunc(
+			func(ctx context.Context, gameID, statisticID string) ([]Leaderboard, error) {
+				return []Leaderboard{failed, {ID: uuid.NewString()}}, nil
+			},
+			func(ctx context.Context, lb Leaderboard, playerID string, value float64, scopes []Scope, caller Caller) error {
+				calls++
+				if lb.ID == failed.ID {
+					return errors.New("any error")
+				}
+
+				return nil
+			},
+		)
+
+		err := upsertStatisticPlayerRanksFunc(ctx, gameID, statisticID, playerID, 42)
+		assert.ErrorContains(t, err, failed.ID)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("List Leaderboards Error", func(t *testing.T) {
+		upsertStatisticPlayerRanksFunc := BuildUpsertStatisticPlayerRanksFunc(
+			func(ctx context.Context, gameID, statisticID string) ([]Leaderboard, error) {
+				return nil, errors.New("any error")
+			},
+			nil,
+		)
+
+		err := upsertStatisticPlayerRanksFunc(ctx, gameID, statisticID, playerID, 42)
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,9 @@
 package leaderboard
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Storage function that is responsible for creating the leaderboard
@@ -9,12 +12,120 @@ type (
 	// Storage function that returns a leaderboard by it's id and game id
 	StorageGetLeaderboardByIDAndGameIDFunc func(ctx context.Context, id, gameID string) (Leaderboard, error)
 
+	// Storage function that returns the game's leaderboards that match the filter paginated, most recent first
+	StorageListLeaderboardsFunc func(ctx context.Context, gameID string, filter LeaderboardsFilter, page, limit int64) (Leaderboards, error)
+
+	// Storage function that returns every leaderboard of the game that's not deleted, most recent first
+	StorageListGameLeaderboardsFunc func(ctx context.Context, gameID string) ([]Leaderboard, error)
+
+	// Storage function that returns the leaderboards with the ids provided, deleted ones included. Leaderboards that no longer exist are skipped
+	StorageGetLeaderboardsByIDsFunc func(ctx context.Context, ids []string) ([]Leaderboard, error)
+
+	// Storage function that returns the player's rank on the global ranking of each leaderboard, on the period provided for it, in a single round trip.
+	// Periods follow the leaderboards order and the leaderboards that don't rank the player are skipped
+	StorageGetPlayerLeaderboardsRanksFunc func(ctx context.Context, playerID string, leaderboards []Leaderboard, periods []string) ([]LeaderboardRank, error)
+
+	// Storage function that checks if the game has the statistic, ignoring the deleted ones
+	StorageStatisticExistsFunc func(ctx context.Context, gameID, statisticID string) (bool, error)
+
+	// Storage function that returns every leaderboard of the game bound to the statistic, deleted ones included
+	StorageListLeaderboardsByStatisticFunc func(ctx context.Context, gameID, statisticID string) ([]Leaderboard, error)
+
+	// Storage function that stores the leaderboard name, description and end time, returning it with its update time
+	StorageUpdateLeaderboardFunc func(ctx context.Context, leaderboard Leaderboard) (Leaderboard, error)
+
 	// Storage function that soft delete a leaderboard
 	StorageSoftDeleteLeaderboardFunc func(ctx context.Context, id, gameID string) error
 
-	// Updates the player's rank value using the value provided
-	StorageUpsertPlayerRankValueFunc func(ctx context.Context, leaderboard Leaderboard, playerID string, value float64) error
+	// Storage function that restores a soft deleted leaderboard
+	StorageRestoreLeaderboardFunc func(ctx context.Context, id, gameID string) (Leaderboard, error)
+
+	// Storage function that returns the leaderboards soft deleted before the time provided, oldest first
+	StorageListDeletedLeaderboardsFunc func(ctx context.Context, deletedBefore time.Time, limit int64) ([]Leaderboard, error)
+
+	// Storage function that permanently removes the leaderboard definition
+	StoragePurgeLeaderboardFunc func(ctx context.Context, leaderboard Leaderboard) error
+
+	// Storage function that permanently removes the rankings of every leaderboard period and every data kept for its players
+	StoragePurgeRankingsFunc func(ctx context.Context, leaderboardID string) error
+
+	// Storage function that permanently removes the results and rewards of every leaderboard period
+	StoragePurgeResultsFunc func(ctx context.Context, leaderboardID string) error
+
+	// Updates the player's rank value on the period ranking and on each scope partition, all at once, using the value provided and records the submission on the player's history.
+	// Returns how the submission changed the player's position on the global ranking, along with the players it pushed out of the leaderboard top
+	StorageUpsertPlayerRankValueFunc func(ctx context.Context, leaderboard Leaderboard, period, playerID string, value float64, scopes []Scope, caller Caller) (RankChange, error)
+
+	// Updates the rank value of each entry, in a single round trip, returning one error per entry (nil when the entry was applied). Every entry applied is recorded on its player's history.
+	// Returns the rank change of each entry, following the entries order, which is empty when the entry wasn't applied
+	StorageUpsertPlayerRankValuesFunc func(ctx context.Context, leaderboard Leaderboard, period string, entries []RankEntry, caller Caller) ([]RankChange, []error, error)
+
+	// Updates the player's rank value on every leaderboard provided at once, checking every update before applying any, so either every update is applied or none is. Returns one error per update, following the updates order,
+	// which is nil when nothing prevented the update. When any update fails, none is applied. Returns the rank change of each update otherwise, following the updates order
+	StorageUpsertPlayerLeaderboardsRankValuesFunc func(ctx context.Context, playerID string, updates []PlayerRankUpdate, caller Caller) ([]RankChange, []error, error)
+
+	// Records the rejected submissions for review
+	StorageRecordRejectionsFunc func(ctx context.Context, leaderboardID string, rejections []Rejection) error
+
+	// Get the rejected submissions paginated, most recent first, starting right after the cursor. An empty cursor starts from the most recent one
+	StorageGetRejectionsFunc func(ctx context.Context, leaderboardID, cursor string, limit int64) (Rejections, error)
+
+	// Get the player's submissions paginated, most recent first, starting right after the cursor. An empty cursor starts from the most recent one
+	StorageGetPlayerHistoryFunc func(ctx context.Context, leaderboardID, playerID, cursor string, limit int64) (History, error)
+
+	// Removes the player's entry from the period ranking and from every scope partition it was ranked on
+	StorageRemovePlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, period, playerID string) error
+
+	// Stores the ban, rejecting every rank update of the player from now on
+	StorageBanPlayerFunc func(ctx context.Context, ban Ban) error
+
+	// Get the players banned from the leaderboard paginated, most recent first
+	StorageGetBansFunc func(ctx context.Context, leaderboardID string, page, limit int64) (Bans, error)
+
+	// Removes the player's ban
+	StorageUnbanPlayerFunc func(ctx context.Context, leaderboardID, playerID string) error
+
+	// Get the leaderboard period ranking, or one of its scope partitions, paginated
+	StorageGetRankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error)
+
+	// Reads the whole ranking in chunks of the size provided, passing each one to the write function along with the number of players ranked when it was read
+	StorageStreamRankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, chunkSize int64, write func(ranking Ranking) error) error
+
+	// Get the player's rank on the period ranking, or one of its scope partitions, the `around` players ranked right above and below it and the number of players ranked
+	StorageGetPlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error)
+
+	// Get the rank of each player provided on the period ranking, or one of its scope partitions, in a single round trip. Players that are not ranked are skipped and the ranks follow the leaderboard ordering.
+	// Also returns the number of players ranked
+	StorageGetPlayersRankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, playerIDs []string) ([]Rank, int64, error)
+
+	// Schedules the period finalization to the time provided
+	StorageScheduleFinalizationFunc func(ctx context.Context, finalization Finalization, at time.Time) error
+
+	// Claims the finalizations scheduled until the time provided so no other worker runs them at the same time.
+	// Only the ID of their leaderboards is returned
+	StorageClaimDueFinalizationsFunc func(ctx context.Context, until time.Time, limit int64) ([]Finalization, error)
+
+	// Extends the claim of a finalization that is still running. Fails with `ErrFinalizationNotClaimed` when it's no longer scheduled
+	StorageRenewFinalizationFunc func(ctx context.Context, finalization Finalization) error
+
+	// Removes the finalization from the schedule
+	StorageCompleteFinalizationFunc func(ctx context.Context, finalization Finalization) error
+
+	// Stores a page of the frozen period ranking starting at the offset provided
+	StorageAppendResultsFunc func(ctx context.Context, leaderboardID, period string, offset int64, ranks []Rank) error
+
+	// Marks the period results as final, storing its summary
+	StorageSaveResultsFunc func(ctx context.Context, results Results) error
+
+	// Get the frozen period ranking paginated
+	StorageGetResultsFunc func(ctx context.Context, leaderboardID, period string, page, limit int64) (Results, error)
+
+	// Reads the whole frozen period ranking in chunks of the size provided, passing each one to the write function
+	StorageStreamResultsFunc func(ctx context.Context, leaderboardID, period string, chunkSize int64, write func(ranks []Rank) error) error
+
+	// Stores the rewards earned on the period
+	StorageSaveRewardsFunc func(ctx context.Context, rewards []Reward) error
 
-	// Get the leaderboard ranking paginated
-	StorageGetRankingFunc func(ctx context.Context, leaderboardID, ordering string, page, limit int64) ([]Rank, error)
+	// Get the reward earned by the player on the period
+	StorageGetPlayerRewardFunc func(ctx context.Context, leaderboardID, period, playerID string) (Reward, error)
 )
@@ -0,0 +1,62 @@
+package leaderboard
+
+import "errors"
+
+var ErrInvalidTier = errors.New("invalid tier")
+
+const MaxTiers = 20
+
+type Tier struct {
+	Name       string  // Tier's name, unique on the leaderboard
+	Percentile float64 // Top percentage of the ranked players placed on the tier. Zero for score threshold tiers
+	Threshold  float64 // Value that the players must reach, following the leaderboard ordering, to be placed on the tier. Ignored on percentile tiers
+}
+
+func validateTiers(tiers []Tier) error {
+	if len(tiers) > MaxTiers {
+		return ErrInvalidTier
+	}
+
+	names := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" || names[tier.Name] {
+			return ErrInvalidTier
+		}
+		names[tier.Name] = true
+
+		if tier.Percentile < 0 || tier.Percentile > 100 {
+			return ErrInvalidTier
+		}
+	}
+
+	return nil
+}
+
+// Checks if the rank is placed on the tier. The rank percentile must already be calculated
+func (t Tier) places(rank Rank, ordering string) bool {
+	if t.Percentile > 0 {
+		return rank.Percentile <= t.Percentile
+	}
+
+	if ordering == OrderingAsc {
+		return rank.Value <= t.Threshold
+	}
+
+	return rank.Value >= t.Threshold
+}
+
+// Calculates the top percentage of the ranked players that each rank is in and places it on the first tier that accepts it
+func (l Leaderboard) placeRanks(ranks []Rank, total int64) {
+	for i := range ranks {
+		if total > 0 {
+			ranks[i].Percentile = float64(ranks[i].Position+1) * 100 / float64(total)
+		}
+
+		for _, tier := range l.Tiers {
+			if tier.places(ranks[i], l.Ordering) {
+				ranks[i].Tier = tier.Name
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTiers(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		err := validateTiers([]Tier{
+			{Name: "Gold", Percentile: 3},
+			{Name: "Silver", Percentile: 20},
+			{Name: "Bronze", Threshold: 0},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Empty Name", func(t *testing.T) {
+		assert.ErrorIs(t, validateTiers([]Tier{{Percentile: 10}}), ErrInvalidTier)
+	})
+
+	t.Run("Duplicated Name", func(t *testing.T) {
+		assert.ErrorIs(t, validateTiers([]Tier{{Name: "Gold", Percentile: 3}, {Name: "Gold", Threshold: 100}}), ErrInvalidTier)
+	})
+
+	t.Run("Invalid Percentile", func(t *testing.T) {
+		assert.ErrorIs(t, validateTiers([]Tier{{Name: "Gold", Percentile: 101}}), ErrInvalidTier)
+		assert.ErrorIs(t, validateTiers([]Tier{{Name: "Gold", Percentile: -1}}), ErrInvalidTier)
+	})
+
+	t.Run("Too Many Tiers", func(t *testing.T) {
+		tiers := make([]Tier, MaxTiers+1)
+		for i := range tiers {
+			tiers[i] = Tier{Name: uuid.NewString(), Threshold: float64(i)}
+		}
+
+		assert.ErrorIs(t, validateTiers(tiers), ErrInvalidTier)
+	})
+}
+
+func TestLeaderboardPlaceRanks(t *testing.T) {
+	t.Run("Percentile", func(t *testing.T) {
+		lb := Leaderboard{Ordering: OrderingDesc, Tiers: []Tier{{Name: "Gold", Percentile: 3}, {Name: "Silver", Percentile: 20}}}
+
+		ranks := []Rank{{Position: 0}, {Position: 2}, {Position: 3}, {Position: 19}, {Position: 20}}
+		lb.placeRanks(ranks, 100)
+
+		assert.Equal(t, []float64{1, 3, 4, 20, 21}, []float64{ranks[0].Percentile, ranks[1].Percentile, ranks[2].Percentile, ranks[3].Percentile, ranks[4].Percentile})
+		assert.Equal(t, []string{"Gold", "Gold", "Silver", "Silver", ""}, []string{ranks[0].Tier, ranks[1].Tier, ranks[2].Tier, ranks[3].Tier, ranks[4].Tier})
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		tiers := []Tier{{Name: "Gold", Threshold: 100}, {Name: "Silver", Threshold: 50}}
+
+		ranks := []Rank{{Position: 0, Value: 100}, {Position: 1, Value: 60}, {Position: 2, Value: 10}}
+		Leaderboard{Ordering: OrderingDesc, Tiers: tiers}.placeRanks(ranks, 3)
+		assert.Equal(t, []string{"Gold", "Silver", ""}, []string{ranks[0].Tier, ranks[1].Tier, ranks[2].Tier})
+
+		ranks = []Rank{{Position: 0, Value: 10}, {Position: 1, Value: 60}, {Position: 2, Value: 120}}
+		Leaderboard{Ordering: OrderingAsc, Tiers: tiers}.placeRanks(ranks, 3)
+		assert.Equal(t, []string{"Gold", "Gold", ""}, []string{ranks[0].Tier, ranks[1].Tier, ranks[2].Tier})
+	})
+
+	t.Run("No Players Ranked", func(t *testing.T) {
+		ranks := []Rank{{Position: 0}}
+		Leaderboard{Ordering: OrderingDesc}.placeRanks(ranks, 0)
+
+		assert.Zero(t, ranks[0].Percentile)
+		assert.Empty(t, ranks[0].Tier)
+	})
+}
+
+func TestBuildPlayerRankFuncPlacesRanks(t *testing.T) {
+	lb := Leaderboard{ID: uuid.NewString(), Ordering: OrderingDesc, Tiers: []Tier{{Name: "Gold", Percentile: 10}}}
+
+	playerRankFunc := BuildPlayerRankFunc(func(ctx context.Context, lb Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error) {
+		return PlayerRank{
+			Total: 10,
+			Rank:  Rank{PlayerID: playerID, Position: 1},
+			Above: []Rank{{PlayerID: "above", Position: 0}},
+			Below: []Rank{{PlayerID: "below", Position: 2}},
+		}, nil
+	})
+
+	playerRank, err := playerRankFunc(context.Background(), lb, "", Scope{}, "player", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(20), playerRank.Percentile)
+	assert.Empty(t, playerRank.Tier)
+	assert.Equal(t, "Gold", playerRank.Above[0].Tier)
+	assert.Equal(t, float64(30), playerRank.Below[0].Percentile)
+}
@@ -1,6 +1,9 @@
 package leaderboard
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Create a leaderboard and return it's id
@@ -9,12 +12,75 @@ type (
 	// Get a leaderboard by it's id and game id
 	GetByIDAndGameIDFunc func(ctx context.Context, id, gameID string) (Leaderboard, error)
 
+	// Leaderboards of the game paginated, most recent first, filtered by status and by a case insensitive search on their names. An empty status lists every leaderboard that's not deleted
+	ListFunc func(ctx context.Context, gameID, status, search string, page, limit int64) (Leaderboards, error)
+
+	// Update the leaderboard name, description and end time, rescheduling the finalization of the period in progress when the end time changes
+	UpdateFunc func(ctx context.Context, id, gameID string, data UpdateLeaderboardData) (Leaderboard, error)
+
 	// Soft Delete a leaderboard
 	SoftDeleteFunc func(ctx context.Context, id, gameID string) error
 
-	// Set or update the player's rank
-	UpsertPlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, playerID string, value float64) error
+	// Restore a soft deleted leaderboard, scheduling the finalization of the period in progress again
+	RestoreFunc func(ctx context.Context, id, gameID string) (Leaderboard, error)
+
+	// Permanently removes the leaderboards deleted longer than the retention ago
+	PurgeDeletedFunc func(ctx context.Context, retention time.Duration) error
+
+	// Set or update the player's rank on the global ranking and on the scope partitions provided
+	UpsertPlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, playerID string, value float64, scopes []Scope, caller Caller) error
+
+	// Set the player's rank on every leaderboard bound to the statistic, using the player's statistic value
+	UpsertStatisticPlayerRanksFunc func(ctx context.Context, gameID, statisticID, playerID string, value float64) error
+
+	// Set or update the rank of many players at once, returning one error per entry (nil when the entry was applied)
+	UpsertPlayerRanksFunc func(ctx context.Context, leaderboard Leaderboard, entries []RankEntry, caller Caller) ([]error, error)
+
+	// Set or update the player's rank on many leaderboards of the caller's game at once, using the values provided by leaderboard ID. Either every rank is updated or none is
+	UpsertPlayerLeaderboardsRanksFunc func(ctx context.Context, playerID string, values map[string]float64, caller Caller) error
+
+	// Every submission made to the player's rank paginated, most recent first. An empty cursor starts from the most recent one
+	PlayerHistoryFunc func(ctx context.Context, leaderboard Leaderboard, playerID, cursor string, limit int64) (History, error)
+
+	// Submissions rejected by the leaderboard rules paginated, most recent first. An empty cursor starts from the most recent one
+	RejectionsFunc func(ctx context.Context, leaderboard Leaderboard, cursor string, limit int64) (Rejections, error)
+
+	// Removes the player's entry from the current ranking, including its scope partitions
+	RemovePlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, playerID string) error
+
+	// Bans the player from the leaderboard, removing its entry from the current ranking and rejecting its future rank updates
+	BanPlayerFunc func(ctx context.Context, leaderboard Leaderboard, playerID, reason string) (Ban, error)
+
+	// Players banned from the leaderboard paginated, most recent first
+	BansFunc func(ctx context.Context, leaderboard Leaderboard, page, limit int64) (Bans, error)
+
+	// Lifts the player's ban from the leaderboard
+	UnbanPlayerFunc func(ctx context.Context, leaderboard Leaderboard, playerID string) error
+
+	// Leaderboard ranking paginated. An empty period returns the current one and an empty scope the global ranking
+	RankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, page, limit int64) (Ranking, error)
+
+	// Whole leaderboard ranking, streamed chunk by chunk. An empty period exports the current one and an empty scope the global ranking
+	ExportRankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope) (Export, error)
+
+	// Player's rank with the `around` players ranked right above and below it. An empty period returns the current one and an empty scope the global ranking
+	PlayerRankFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, playerID string, around int64) (PlayerRank, error)
+
+	// Player's rank on the current period of every leaderboard of the game that's not deleted and ranks the player, most recent leaderboards first
+	PlayerLeaderboardsRanksFunc func(ctx context.Context, gameID, playerID string) ([]LeaderboardRank, error)
+
+	// Ranking of the players provided, like a player's friends, with their global and relative positions. An empty period returns the current one and an empty scope the global ranking
+	PlayersRankingFunc func(ctx context.Context, leaderboard Leaderboard, period string, scope Scope, playerIDs []string) (PlayersRanking, error)
+
+	// Final ranking of a closed period paginated. An empty period returns the last one closed
+	ResultsFunc func(ctx context.Context, leaderboard Leaderboard, period string, page, limit int64) (Results, error)
+
+	// Whole final ranking of a closed period, streamed chunk by chunk. An empty period exports the last one closed
+	ExportResultsFunc func(ctx context.Context, leaderboard Leaderboard, period string) (Export, error)
+
+	// Freezes the ranking of every period that has closed and notifies its results
+	FinalizeDueFunc func(ctx context.Context) error
 
-	// Leaderboard ranking paginated
-	RankingFunc func(ctx context.Context, leaderboard Leaderboard, page, limit int64) ([]Rank, error)
+	// Reward earned by the player on a closed period. An empty period returns the last one closed
+	PlayerRewardFunc func(ctx context.Context, leaderboard Leaderboard, period, playerID string) (Reward, error)
 )
@@ -0,0 +1,44 @@
+package leaderboard
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var ErrInvalidRollingWindow = errors.New("invalid rolling window")
+
+const (
+	RollingWindowStep = time.Hour           // Granularity of the rolling windows. Submissions drop from the ranking one step at a time
+	MaxRollingWindow  = 30 * 24 * time.Hour // Longest rolling window supported
+)
+
+// Aggregation modes whose values can be merged across the steps of a rolling window
+var RollingAggregationModes = []string{
+	AggregationModeInc,
+	AggregationModeMax,
+	AggregationModeMin,
+	AggregationModeCount,
+}
+
+// Rolling windows replace the ranking periods and rank each player once, so ties can't be broken by time
+func (l NewLeaderboardData) validateRollingWindow() error {
+	if l.RollingWindow == 0 {
+		return nil
+	}
+
+	if l.RollingWindow < 0 || l.RollingWindow > MaxRollingWindow || l.RollingWindow%RollingWindowStep != 0 {
+		return ErrInvalidRollingWindow
+	}
+
+	if l.Recurrence != RecurrenceNone || l.TieBreakPolicy != TieBreakPolicyNone || !slices.Contains(RollingAggregationModes, l.AggregationMode) {
+		return ErrInvalidRollingWindow
+	}
+
+	return nil
+}
+
+// Checks if only the submissions made on the last rolling window count towards the ranking
+func (l Leaderboard) Rolling() bool {
+	return l.RollingWindow > 0
+}
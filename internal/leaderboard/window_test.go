@@ -0,0 +1,59 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRollingWindow(t *testing.T) {
+	data := NewLeaderboardData{
+		AggregationMode: AggregationModeMax,
+		TieBreakPolicy:  TieBreakPolicyNone,
+		Recurrence:      RecurrenceNone,
+		RollingWindow:   7 * 24 * time.Hour,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		assert.NoError(t, data.validateRollingWindow())
+		assert.NoError(t, NewLeaderboardData{AggregationMode: AggregationModeLast}.validateRollingWindow())
+	})
+
+	t.Run("Invalid Duration", func(t *testing.T) {
+		for _, window := range []time.Duration{-time.Hour, 90 * time.Minute, MaxRollingWindow + time.Hour} {
+			invalid := data
+			invalid.RollingWindow = window
+
+			assert.ErrorIs(t, invalid.validateRollingWindow(), ErrInvalidRollingWindow)
+		}
+	})
+
+	t.Run("Recurring Leaderboard", func(t *testing.T) {
+		invalid := data
+		invalid.Recurrence = RecurrenceDaily
+
+		assert.ErrorIs(t, invalid.validateRollingWindow(), ErrInvalidRollingWindow)
+	})
+
+	t.Run("Tie Break Policy", func(t *testing.T) {
+		invalid := data
+		invalid.TieBreakPolicy = TieBreakPolicyEarliest
+
+		assert.ErrorIs(t, invalid.validateRollingWindow(), ErrInvalidRollingWindow)
+	})
+
+	t.Run("Aggregation Mode", func(t *testing.T) {
+		for _, mode := range []string{AggregationModeLast, AggregationModeAvg} {
+			invalid := data
+			invalid.AggregationMode = mode
+
+			assert.ErrorIs(t, invalid.validateRollingWindow(), ErrInvalidRollingWindow)
+		}
+	})
+}
+
+func TestRolling(t *testing.T) {
+	assert.True(t, Leaderboard{RollingWindow: time.Hour}.Rolling())
+	assert.False(t, Leaderboard{}.Rolling())
+}
@@ -25,9 +25,26 @@ type (
 		UpdatedAt        time.Time               // Last time the player updated the quest progression
 		PlayerID         string                  // Player's ID
 		Quest            Quest                   // Quest Config Data
+		QuestVersion     int                     // Version of the quest the player is progressing on
 		CompletedAt      time.Time               // Time the player completed the quest
 		TasksProgression []PlayerTaskProgression // Tasks progression
 	}
+
+	PlayerQuestSummary struct {
+		StartedAt      time.Time // Time the player started the quest
+		UpdatedAt      time.Time // Last time the player updated the quest progression
+		PlayerID       string    // Player's ID
+		Quest          Quest     // Quest config data, without its tasks
+		QuestVersion   int       // Version of the quest the player is progressing on
+		CompletedAt    time.Time // Time the player completed the quest
+		TasksCompleted int64     // Number of the quest tasks completed by the player
+		TasksTotal     int64     // Number of tasks of the quest version the player is progressing on
+	}
+
+	PlayerQuests struct {
+		Total  int64                // Number of quests started by the player
+		Quests []PlayerQuestSummary // Player quests page, most recently started first
+	}
 )
 
 func (p PlayerQuestProgression) applyRuleToActiveTasks(data string) ([]string, error) {
@@ -62,6 +79,16 @@ func BuildGetPlayerQuestProgression(storageGetPlayerQuestProgressionFunc Storage
 	}
 }
 
+func BuildListPlayerQuestsFunc(storageListPlayerQuestsFunc StorageListPlayerQuestsFunc) ListPlayerQuestsFunc {
+	return func(ctx context.Context, gameID, playerID string, page, limit int64) (PlayerQuests, error) {
+		if err := validatePage(page, limit); err != nil {
+			return PlayerQuests{}, err
+		}
+
+		return storageListPlayerQuestsFunc(ctx, gameID, playerID, page, limit)
+	}
+}
+
 func BuildUpdatePlayerQuestProgressionFunc(
 	notifierPlayerProgressionUpdates NotifierPlayerProgressionUpdates,
 	storageGetPlayerQuestProgressionFunc StorageGetPlayerQuestProgressionFunc,
@@ -98,3 +125,36 @@ func BuildUpdatePlayerQuestProgressionFunc(
 		return playerProgression, nil
 	}
 }
+
+func BuildMigratePlayerQuestFunc(
+	notifierPlayerProgressionUpdates NotifierPlayerProgressionUpdates,
+	storageGetPlayerQuestProgressionFunc StorageGetPlayerQuestProgressionFunc,
+	storageMigratePlayerQuestFunc StorageMigratePlayerQuestFunc,
+) MigratePlayerQuestFunc {
+	return func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+		previousProgression, err := storageGetPlayerQuestProgressionFunc(ctx, quest, playerID)
+		if err != nil {
+			return PlayerQuestProgression{}, err
+		}
+
+		if !previousProgression.CompletedAt.IsZero() {
+			return PlayerQuestProgression{}, ErrPlayerQuestAlreadyCompleted
+		}
+
+		playerProgression, err := storageMigratePlayerQuestFunc(ctx, quest, playerID)
+		if err != nil {
+			return PlayerQuestProgression{}, err
+		}
+
+		// Already on the latest version, so nothing changed
+		if playerProgression.QuestVersion == previousProgression.QuestVersion {
+			return playerProgression, nil
+		}
+
+		if err = notifierPlayerProgressionUpdates(ctx, playerProgression); err != nil {
+			return PlayerQuestProgression{}, err
+		}
+
+		return playerProgression, nil
+	}
+}
@@ -138,6 +138,51 @@ func TestBuildGetPlayerQuestProgression(t *testing.T) {
 	})
 }
 
+func TestBuildListPlayerQuestsFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		gameID   = uuid.NewString()
+		playerID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		listPlayerQuestsFunc := BuildListPlayerQuestsFunc(func(ctx context.Context, id, player string, page, limit int64) (PlayerQuests, error) {
+			assert.Equal(t, gameID, id)
+			assert.Equal(t, playerID, player)
+			return PlayerQuests{Total: 1, Quests: []PlayerQuestSummary{{PlayerID: player, TasksCompleted: 1, TasksTotal: 2}}}, nil
+		})
+
+		playerQuests, err := listPlayerQuestsFunc(ctx, gameID, playerID, 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), playerQuests.Total)
+		assert.Len(t, playerQuests.Quests, 1)
+	})
+
+	t.Run("Page Number Lower Than Minimun", func(t *testing.T) {
+		listPlayerQuestsFunc := BuildListPlayerQuestsFunc(nil)
+
+		_, err := listPlayerQuestsFunc(ctx, gameID, playerID, MinPageNumber-1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPageNumber)
+	})
+
+	t.Run("Limit Number Lower Than Minimun", func(t *testing.T) {
+		listPlayerQuestsFunc := BuildListPlayerQuestsFunc(nil)
+
+		_, err := listPlayerQuestsFunc(ctx, gameID, playerID, 0, MinLimitNumber-1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		listPlayerQuestsFunc := BuildListPlayerQuestsFunc(func(ctx context.Context, gameID, playerID string, page, limit int64) (PlayerQuests, error) {
+			return PlayerQuests{}, errors.New("any error")
+		})
+
+		_, err := listPlayerQuestsFunc(ctx, gameID, playerID, 0, 10)
+		assert.Error(t, err)
+	})
+}
+
 func TestBuildUpdatePlayerQuestProgressionFunc(t *testing.T) {
 	var (
 		ctx = context.Background()
@@ -337,3 +382,105 @@ func TestBuildUpdatePlayerQuestProgressionFunc(t *testing.T) {
 		assert.Empty(t, progression.Quest.ID)
 	})
 }
+
+func TestBuildMigratePlayerQuestFunc(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		playerID = uuid.NewString()
+		quest    = Quest{ID: uuid.NewString(), GameID: uuid.NewString(), Version: 2}
+	)
+
+	getProgressionFunc := func(progression PlayerQuestProgression) StorageGetPlayerQuestProgressionFunc {
+		return func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+			progression.PlayerID = playerID
+			progression.Quest = quest
+			return progression, nil
+		}
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		notified := false
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			func(ctx context.Context, progression PlayerQuestProgression) error {
+				notified = true
+				return nil
+			},
+			getProgressionFunc(PlayerQuestProgression{QuestVersion: 1}),
+			func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+				return PlayerQuestProgression{PlayerID: playerID, Quest: quest, QuestVersion: quest.Version}, nil
+			},
+		)
+
+		progression, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.NoError(t, err)
+		assert.Equal(t, quest.Version, progression.QuestVersion)
+		assert.True(t, notified)
+	})
+
+	t.Run("Already On The Latest Version", func(t *testing.T) {
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			nil,
+			getProgressionFunc(PlayerQuestProgression{QuestVersion: 2}),
+			func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+				return PlayerQuestProgression{PlayerID: playerID, Quest: quest, QuestVersion: quest.Version}, nil
+			},
+		)
+
+		progression, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.NoError(t, err)
+		assert.Equal(t, quest.Version, progression.QuestVersion)
+	})
+
+	t.Run("Quest Already Completed", func(t *testing.T) {
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			nil,
+			getProgressionFunc(PlayerQuestProgression{QuestVersion: 1, CompletedAt: time.Now()}),
+			nil,
+		)
+
+		_, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.ErrorIs(t, err, ErrPlayerQuestAlreadyCompleted)
+	})
+
+	t.Run("Get Progression Error", func(t *testing.T) {
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			nil,
+			func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+				return PlayerQuestProgression{}, ErrQuestNotFound
+			},
+			nil,
+		)
+
+		_, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.ErrorIs(t, err, ErrQuestNotFound)
+	})
+
+	t.Run("Migrate Error", func(t *testing.T) {
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			nil,
+			getProgressionFunc(PlayerQuestProgression{QuestVersion: 1}),
+			func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+				return PlayerQuestProgression{}, errors.New("any error")
+			},
+		)
+
+		_, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Progression Notifier Error", func(t *testing.T) {
+		migratePlayerQuestFunc := BuildMigratePlayerQuestFunc(
+			func(ctx context.Context, progression PlayerQuestProgression) error {
+				return errors.New("any error")
+			},
+			getProgressionFunc(PlayerQuestProgression{QuestVersion: 1}),
+			func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error) {
+				return PlayerQuestProgression{PlayerID: playerID, Quest: quest, QuestVersion: quest.Version}, nil
+			},
+		)
+
+		_, err := migratePlayerQuestFunc(ctx, quest, playerID)
+		assert.Error(t, err)
+	})
+}
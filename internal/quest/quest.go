@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"time"
 )
 
@@ -16,6 +17,15 @@ var (
 	ErrInvalidQuestID                     = errors.New("invalid quest id")
 	ErrQuestNotFound                      = errors.New("quest not found")
 	ErrQuestWithoutTasks                  = errors.New("a quest task list must not be empty")
+	ErrInvalidPageNumber                  = errors.New("invalid page number")
+	ErrInvalidLimitNumber                 = errors.New("invalid limit number")
+	ErrInvalidCreatedRange                = errors.New("invalid created range")
+)
+
+const (
+	MaxLimitNumber = 500
+	MinLimitNumber = 1
+	MinPageNumber  = 0
 )
 
 type NewQuestData struct {
@@ -34,9 +44,48 @@ type Quest struct {
 	GameID      string    // ID of the game responsible for the quest
 	Name        string    // Quest name
 	Description string    // Quest details
+	Version     int       // Quest version. Every edit creates a new one, with its own tasks
 	Tasks       []Task    // Quest task list
 }
 
+type QuestsFilter struct {
+	Deleted       bool      // Lists the deleted quests instead of the ones that are not deleted
+	Search        string    // Text that the quest name must contain, ignoring the case
+	CreatedAfter  time.Time // Lists the quests created at or after this time. Ignored when zero
+	CreatedBefore time.Time // Lists the quests created before this time. Ignored when zero
+}
+
+type Quests struct {
+	Total  int64   // Number of quests that match the filters
+	Quests []Quest // Quests page, most recent first
+}
+
+func validatePage(page, limit int64) error {
+	if page < MinPageNumber {
+		return ErrInvalidPageNumber
+	}
+
+	if limit < MinLimitNumber || limit > MaxLimitNumber {
+		return ErrInvalidLimitNumber
+	}
+
+	return nil
+}
+
+func (q NewQuestData) withDefaultTaskKeys() NewQuestData {
+	tasks := make([]NewTaskData, len(q.Tasks))
+	for i, task := range q.Tasks {
+		if task.Key == "" {
+			task.Key = strconv.Itoa(i)
+		}
+
+		tasks[i] = task
+	}
+
+	q.Tasks = tasks
+	return q
+}
+
 func (q NewQuestData) validate() error {
 	errList := make([]error, 0)
 
@@ -53,12 +102,20 @@ func (q NewQuestData) validate() error {
 	} else if len(q.Tasks) != len(q.TasksValidators) {
 		errList = append(errList, ErrQuestTaskRuleSuceessDataIncomplete)
 	} else {
-		itsOkValidateDependencyCycle := true
+		var (
+			itsOkValidateDependencyCycle = true
+			keys                         = make(map[string]bool)
+		)
 		for i, task := range q.Tasks {
 			if err := task.validate(q.TasksValidators[i]); err != nil {
 				errList = append(errList, fmt.Errorf("Task #%d\n%w", i, err))
 			}
 
+			if keys[task.Key] {
+				errList = append(errList, fmt.Errorf("Task #%d\n%w", i, ErrDuplicatedTaskKey))
+			}
+			keys[task.Key] = true
+
 			for _, dependencyIndex := range task.DependsOn {
 				if dependencyIndex < 0 || dependencyIndex >= len(q.Tasks) {
 					itsOkValidateDependencyCycle = false
@@ -83,6 +140,7 @@ func (q NewQuestData) validate() error {
 
 func BuildCreateQuestFunc(storageCreateQuestFunc StorageCreateQuestFunc) CreateQuestFunc {
 	return func(ctx context.Context, data NewQuestData) (Quest, error) {
+		data = data.withDefaultTaskKeys()
 		if err := data.validate(); err != nil {
 			return Quest{}, err
 		}
@@ -91,14 +149,51 @@ func BuildCreateQuestFunc(storageCreateQuestFunc StorageCreateQuestFunc) CreateQ
 	}
 }
 
+func BuildUpdateQuestFunc(storageUpdateQuestFunc StorageUpdateQuestFunc) UpdateQuestFunc {
+	return func(ctx context.Context, questID string, data NewQuestData) (Quest, error) {
+		data = data.withDefaultTaskKeys()
+		if err := data.validate(); err != nil {
+			return Quest{}, err
+		}
+
+		return storageUpdateQuestFunc(ctx, questID, data)
+	}
+}
+
 func BuildGetQuestByIDAndGameIDFunc(storageGetQuestFunc StorageGetQuestFunc) GetQuestByIDAndGameIDFunc {
 	return func(ctx context.Context, id, gameID string) (Quest, error) {
 		return storageGetQuestFunc(ctx, id, gameID)
 	}
 }
 
+func BuildListQuestsFunc(storageListQuestsFunc StorageListQuestsFunc) ListQuestsFunc {
+	return func(ctx context.Context, gameID string, filter QuestsFilter, page, limit int64) (Quests, error) {
+		if err := validatePage(page, limit); err != nil {
+			return Quests{}, err
+		}
+
+		if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+			return Quests{}, ErrInvalidCreatedRange
+		}
+
+		return storageListQuestsFunc(ctx, gameID, filter, page, limit)
+	}
+}
+
 func BuildSoftDeleteQuestFunc(storageSoftDeleteQuestFunc StorageSoftDeleteQuestFunc) SoftDeleteQuestFunc {
 	return func(ctx context.Context, questID, gameID string) error {
 		return storageSoftDeleteQuestFunc(ctx, questID, gameID)
 	}
 }
+
+func BuildRestoreQuestFunc(storageRestoreQuestFunc StorageRestoreQuestFunc) RestoreQuestFunc {
+	return func(ctx context.Context, questID, gameID string) (Quest, error) {
+		return storageRestoreQuestFunc(ctx, questID, gameID)
+	}
+}
+
+func BuildPurgeDeletedQuestsFunc(storagePurgeDeletedQuestsFunc StoragePurgeDeletedQuestsFunc) PurgeDeletedQuestsFunc {
+	return func(ctx context.Context, retention time.Duration) error {
+		return storagePurgeDeletedQuestsFunc(ctx, time.Now().Add(-retention))
+	}
+}
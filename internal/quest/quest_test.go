@@ -1,7 +1,10 @@
 package quest
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -77,3 +80,142 @@ func TestQuestValidate(t *testing.T) {
 		assert.NotErrorIs(t, err, ErrTaskValidationError)
 	})
 }
+
+func TestBuildUpdateQuestFunc(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		questID = uuid.NewString()
+		rule    = `{">": [{"var": "killed.terrorists"}, 150]}`
+	)
+
+	newQuestData := func(keys ...string) NewQuestData {
+		data := NewQuestData{GameID: uuid.NewString(), Name: "Test Quest"}
+		for _, key := range keys {
+			data.Tasks = append(data.Tasks, NewTaskData{Key: key, Name: "Test Task", Rule: rule})
+			data.TasksValidators = append(data.TasksValidators, `{"killed": {"terrorists": 200}}`)
+		}
+
+		return data
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		updateQuestFunc := BuildUpdateQuestFunc(func(ctx context.Context, id string, data NewQuestData) (Quest, error) {
+			assert.Equal(t, questID, id)
+			// Missing keys default to the task position
+			assert.Equal(t, "kill", data.Tasks[0].Key)
+			assert.Equal(t, "1", data.Tasks[1].Key)
+			return Quest{ID: id, GameID: data.GameID, Name: data.Name, Version: 2}, nil
+		})
+
+		data := newQuestData("kill", "")
+		quest, err := updateQuestFunc(ctx, questID, data)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, quest.Version)
+		assert.Empty(t, data.Tasks[1].Key)
+	})
+
+	t.Run("Duplicated Task Key", func(t *testing.T) {
+		updateQuestFunc := BuildUpdateQuestFunc(nil)
+
+		_, err := updateQuestFunc(ctx, questID, newQuestData("1", ""))
+		assert.ErrorIs(t, err, ErrQuestValidationError)
+		assert.ErrorIs(t, err, ErrDuplicatedTaskKey)
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		updateQuestFunc := BuildUpdateQuestFunc(nil)
+
+		_, err := updateQuestFunc(ctx, questID, newQuestData())
+		assert.ErrorIs(t, err, ErrQuestValidationError)
+		assert.ErrorIs(t, err, ErrQuestWithoutTasks)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		updateQuestFunc := BuildUpdateQuestFunc(func(ctx context.Context, questID string, data NewQuestData) (Quest, error) {
+			return Quest{}, errors.New("any error")
+		})
+
+		_, err := updateQuestFunc(ctx, questID, newQuestData("kill"))
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildListQuestsFunc(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		gameID = uuid.NewString()
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		filter := QuestsFilter{Deleted: true, Search: "dragon", CreatedAfter: time.Now().AddDate(0, -1, 0), CreatedBefore: time.Now()}
+
+		listQuestsFunc := BuildListQuestsFunc(func(ctx context.Context, id string, f QuestsFilter, page, limit int64) (Quests, error) {
+			assert.Equal(t, gameID, id)
+			assert.Equal(t, filter, f)
+			assert.Equal(t, int64(1), page)
+			assert.Equal(t, int64(10), limit)
+			return Quests{Total: 11, Quests: []Quest{{ID: uuid.NewString(), GameID: id}}}, nil
+		})
+
+		quests, err := listQuestsFunc(ctx, gameID, filter, 1, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), quests.Total)
+		assert.Len(t, quests.Quests, 1)
+	})
+
+	t.Run("Page Number Lower Than Minimun", func(t *testing.T) {
+		listQuestsFunc := BuildListQuestsFunc(nil)
+
+		_, err := listQuestsFunc(ctx, gameID, QuestsFilter{}, MinPageNumber-1, 10)
+		assert.ErrorIs(t, err, ErrInvalidPageNumber)
+	})
+
+	t.Run("Limit Number Greater Than Maximum", func(t *testing.T) {
+		listQuestsFunc := BuildListQuestsFunc(nil)
+
+		_, err := listQuestsFunc(ctx, gameID, QuestsFilter{}, 0, MaxLimitNumber+1)
+		assert.ErrorIs(t, err, ErrInvalidLimitNumber)
+	})
+
+	t.Run("Invalid Created Range", func(t *testing.T) {
+		listQuestsFunc := BuildListQuestsFunc(nil)
+
+		_, err := listQuestsFunc(ctx, gameID, QuestsFilter{CreatedAfter: time.Now(), CreatedBefore: time.Now().AddDate(0, -1, 0)}, 0, 10)
+		assert.ErrorIs(t, err, ErrInvalidCreatedRange)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		listQuestsFunc := BuildListQuestsFunc(func(ctx context.Context, gameID string, filter QuestsFilter, page, limit int64) (Quests, error) {
+			return Quests{}, errors.New("any error")
+		})
+
+		_, err := listQuestsFunc(ctx, gameID, QuestsFilter{}, 0, 10)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPurgeDeletedQuestsFunc(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		retention = 7 * 24 * time.Hour
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		purgeDeletedQuestsFunc := BuildPurgeDeletedQuestsFunc(func(ctx context.Context, deletedBefore time.Time) error {
+			assert.WithinDuration(t, time.Now().Add(-retention), deletedBefore, time.Second)
+			return nil
+		})
+
+		err := purgeDeletedQuestsFunc(ctx, retention)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		purgeDeletedQuestsFunc := BuildPurgeDeletedQuestsFunc(func(ctx context.Context, deletedBefore time.Time) error {
+			return errors.New("any error")
+		})
+
+		err := purgeDeletedQuestsFunc(ctx, retention)
+		assert.Error(t, err)
+	})
+}
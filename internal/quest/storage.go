@@ -1,6 +1,9 @@
 package quest
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Creates a quest and its tasks
@@ -9,15 +12,35 @@ type (
 	// Get quest by id and game id
 	StorageGetQuestFunc func(ctx context.Context, id, gameID string) (Quest, error)
 
+	// Creates a new version of the quest with the data provided, with new tasks. Players keep progressing on the version they started
+	StorageUpdateQuestFunc func(ctx context.Context, questID string, data NewQuestData) (Quest, error)
+
+	// List the game's quests that match the filter paginated, most recent first
+	StorageListQuestsFunc func(ctx context.Context, gameID string, filter QuestsFilter, page, limit int64) (Quests, error)
+
 	// Soft deletes a quest and its tasks
 	StorageSoftDeleteQuestFunc func(ctx context.Context, questID, gameID string) error
 
+	// Restores a soft deleted quest and the tasks deleted with it
+	StorageRestoreQuestFunc func(ctx context.Context, questID, gameID string) (Quest, error)
+
+	// Permanently removes the quests soft deleted before the time provided, with their tasks and players progression
+	StoragePurgeDeletedQuestsFunc func(ctx context.Context, deletedBefore time.Time) error
+
 	// Start the quest for a player
 	StorageStartQuestForPlayerFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
 
 	// Get the player quest progression
 	StorageGetPlayerQuestProgressionFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
 
+	// List the game's quests started by the player paginated, most recently started first, with the number of tasks completed
+	StorageListPlayerQuestsFunc func(ctx context.Context, gameID, playerID string, page, limit int64) (PlayerQuests, error)
+
+	// Moves the player quest progression to the latest version of the quest. Does nothing when it's already on the latest one.
+	// A completed task is kept when the new version has a task with the same key whose dependencies are completed as well.
+	// It also marks the player quest as complete if all required tasks of the new version are completed.
+	StorageMigratePlayerQuestFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
+
 	// Marks all player tasks in the `tasksCompleted` list as completed and
 	// starts player tasks that were previously pending waiting for these completions.
 	// It also marks the player quest as complete if all required tasks are completed.
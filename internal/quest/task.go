@@ -14,9 +14,11 @@ var (
 	ErrInvalidSucessRuleDataExemple = errors.New("success exemple task rule data returned false")
 	ErrInvalidTaskDependencyIndex   = errors.New("invalid task dependency array index")
 	ErrTaskDependencyCycle          = errors.New("task dependency cycle detected")
+	ErrDuplicatedTaskKey            = errors.New("duplicated task key")
 )
 
 type NewTaskData struct {
+	Key                   string // Stable task identifier, used to carry the players progression over to newer versions of the quest. Defaults to the task position on the list
 	Name                  string // Task name
 	Description           string // Task details
 	DependsOn             []int  // List of array indexes of the tasks that needs to be completed before this one can be started
@@ -29,6 +31,7 @@ type Task struct {
 	UpdatedAt             time.Time // Last time that the task was updated
 	DeletedAt             time.Time // Time that the task was deleted
 	ID                    string    // Task ID
+	Key                   string    // Stable task identifier, kept across the quest versions
 	Name                  string    // Task name
 	Description           string    // Task details
 	DependsOn             []string  // IDs from the tasks that needs to be completed before this one can be started
@@ -1,6 +1,9 @@
 package quest
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Creates a quest and its tasks
@@ -9,15 +12,34 @@ type (
 	// Get quest by id and game id
 	GetQuestByIDAndGameIDFunc func(ctx context.Context, id, gameID string) (Quest, error)
 
+	// Creates a new version of the quest. New players start the latest version,
+	// while the ones already progressing stay on theirs until they're migrated
+	UpdateQuestFunc func(ctx context.Context, questID string, data NewQuestData) (Quest, error)
+
+	// List the game's quests that match the filter paginated, most recent first
+	ListQuestsFunc func(ctx context.Context, gameID string, filter QuestsFilter, page, limit int64) (Quests, error)
+
 	// Soft deletes a quest and its tasks
 	SoftDeleteQuestFunc func(ctx context.Context, questID, gameID string) error
 
+	// Restores a soft deleted quest and the tasks deleted with it
+	RestoreQuestFunc func(ctx context.Context, questID, gameID string) (Quest, error)
+
+	// Permanently removes the quests deleted longer than the retention ago, with their tasks and players progression
+	PurgeDeletedQuestsFunc func(ctx context.Context, retention time.Duration) error
+
 	// Start the quest for a player
 	StartQuestForPlayerFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
 
 	// Get the player quest progression
 	GetPlayerQuestProgressionFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
 
+	// List the game's quests started by the player paginated, with their progress summary. Deleted quests are skipped
+	ListPlayerQuestsFunc func(ctx context.Context, gameID, playerID string, page, limit int64) (PlayerQuests, error)
+
+	// Moves the player progression to the latest version of the quest, carrying the tasks completed over by their keys
+	MigratePlayerQuestFunc func(ctx context.Context, quest Quest, playerID string) (PlayerQuestProgression, error)
+
 	// Apply `taskDataToCheck` to all active tasks, check if it meets your conditions and update the completion of tasks that do.
 	// When all the required tasks are marked as completed, the quest will also be automatically marked as completed
 	UpdatePlayerQuestProgressionFunc func(ctx context.Context, quest Quest, playerID, taskDataToCheck string) (PlayerQuestProgression, error)
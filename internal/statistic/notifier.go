@@ -5,4 +5,7 @@ import "context"
 type (
 	// Notify player progression updates
 	NotifierPlayerProgressionUpdates func(ctx context.Context, statistic Statistic, progression PlayerProgression, updates PlayerProgressionUpdates) error
+
+	// Notify the player's current value after every progression update
+	NotifierPlayerValueUpdated func(ctx context.Context, gameID, statisticID, playerID string, value float64) error
 )
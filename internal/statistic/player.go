@@ -45,6 +45,7 @@ type (
 
 func BuildUpsertPlayerProgressionFunc(
 	notifierPlayerProgressionUpdates NotifierPlayerProgressionUpdates,
+	notifierPlayerValueUpdated NotifierPlayerValueUpdated,
 	storageUpdatePlayerProgressionFunc StorageUpdatePlayerProgressionFunc,
 ) UpsertPlayerProgressionFunc {
 	return func(ctx context.Context, statistic Statistic, playerID string, value float64) error {
@@ -54,10 +55,16 @@ func BuildUpsertPlayerProgressionFunc(
 		}
 
 		if len(playerProgressionUpdates.LandmarksJustCompleted) > 0 || playerProgressionUpdates.GoalJustCompleted {
-			return notifierPlayerProgressionUpdates(ctx, statistic, playerProgression, playerProgressionUpdates)
+			if err := notifierPlayerProgressionUpdates(ctx, statistic, playerProgression, playerProgressionUpdates); err != nil {
+				return err
+			}
 		}
 
-		return nil
+		if playerProgression.CurrentValue == nil {
+			return nil
+		}
+
+		return notifierPlayerValueUpdated(ctx, statistic.GameID, statistic.ID, playerID, *playerProgression.CurrentValue)
 	}
 }
 
@@ -2,6 +2,7 @@ package statistic
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"testing"
 
@@ -18,6 +19,7 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 
 	t.Run("OK Without Goals Or Landmarks And With Aggregation Mode SUM", func(t *testing.T) {
 		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
 			nil,
 			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 				return PlayerProgression{}, PlayerProgressionUpdates{}, nil
@@ -34,6 +36,7 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 
 	t.Run("OK Without Goals Or Landmarks And With Aggregation Mode SUB", func(t *testing.T) {
 		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
 			nil,
 			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 				return PlayerProgression{}, PlayerProgressionUpdates{}, nil
@@ -50,6 +53,7 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 
 	t.Run("OK Without Goals Or Landmarks And With Aggregation Mode MAX", func(t *testing.T) {
 		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
 			nil,
 			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 				return PlayerProgression{}, PlayerProgressionUpdates{}, nil
@@ -66,6 +70,7 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 
 	t.Run("OK Without Goals Or Landmarks And With Aggregation Mode MIN", func(t *testing.T) {
 		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
 			nil,
 			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 				return PlayerProgression{}, PlayerProgressionUpdates{}, nil
@@ -85,6 +90,7 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 			func(ctx context.Context, statistic Statistic, progression PlayerProgression, updates PlayerProgressionUpdates) error {
 				return nil
 			},
+			nil,
 			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 				return PlayerProgression{}, PlayerProgressionUpdates{}, nil
 			},
@@ -98,9 +104,59 @@ func TestBuildUpsertPlayerProgressionFunc(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("OK Notifying The Player Value", func(t *testing.T) {
+		var (
+			currentValue = rand.Float64()
+			notified     = false
+		)
+
+		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
+			func(ctx context.Context, gameID, statisticID, pID string, value float64) error {
+				assert.Equal(t, "game", gameID)
+				assert.Equal(t, "statistic", statisticID)
+				assert.Equal(t, playerID, pID)
+				assert.Equal(t, currentValue, value)
+				notified = true
+				return nil
+			},
+			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
+				return PlayerProgression{CurrentValue: &currentValue}, PlayerProgressionUpdates{}, nil
+			},
+		)
+
+		statistic := Statistic{
+			ID:              "statistic",
+			GameID:          "game",
+			AggregationMode: AggregationModeSum,
+		}
+
+		err := updatePlayerProgressionFunc(ctx, statistic, playerID, rand.Float64())
+		assert.NoError(t, err)
+		assert.True(t, notified)
+	})
+
+	t.Run("Notify Player Value Error", func(t *testing.T) {
+		currentValue := rand.Float64()
+
+		updatePlayerProgressionFunc := BuildUpsertPlayerProgressionFunc(
+			nil,
+			func(ctx context.Context, gameID, statisticID, playerID string, value float64) error {
+				return errors.New("any error")
+			},
+			func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
+				return PlayerProgression{CurrentValue: &currentValue}, PlayerProgressionUpdates{}, nil
+			},
+		)
+
+		err := updatePlayerProgressionFunc(ctx, Statistic{AggregationMode: AggregationModeSum}, playerID, rand.Float64())
+		assert.Error(t, err)
+	})
+
 	t.Run("Invalid Aggregation Mode", func(t *testing.T) {
 		var (
 			updatePlayerProgressionFunc = BuildUpsertPlayerProgressionFunc(
+				nil,
 				nil,
 				func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error) {
 					return PlayerProgression{}, PlayerProgressionUpdates{}, ErrInvalidAggregationMode
@@ -97,3 +97,15 @@ func BuildSoftDeleteStatistic(storageSoftDeleteStatistic StorageSoftDeleteStatis
 		return storageSoftDeleteStatistic(ctx, id, gameID)
 	}
 }
+
+func BuildRestoreStatistic(storageRestoreStatistic StorageRestoreStatistic) RestoreByIDAndGameIDFunc {
+	return func(ctx context.Context, id, gameID string) (Statistic, error) {
+		return storageRestoreStatistic(ctx, id, gameID)
+	}
+}
+
+func BuildPurgeDeletedStatistics(storagePurgeDeletedStatistics StoragePurgeDeletedStatistics) PurgeDeletedFunc {
+	return func(ctx context.Context, retention time.Duration) error {
+		return storagePurgeDeletedStatistics(ctx, time.Now().Add(-retention))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -107,3 +108,29 @@ func TestBuildCreateStatisticFunc(t *testing.T) {
 		assert.Empty(t, statistic.ID)
 	})
 }
+
+func TestBuildPurgeDeletedStatistics(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		retention = 7 * 24 * time.Hour
+	)
+
+	t.Run("OK", func(t *testing.T) {
+		purgeDeletedFunc := BuildPurgeDeletedStatistics(func(ctx context.Context, deletedBefore time.Time) error {
+			assert.WithinDuration(t, time.Now().Add(-retention), deletedBefore, time.Second)
+			return nil
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Random Error", func(t *testing.T) {
+		purgeDeletedFunc := BuildPurgeDeletedStatistics(func(ctx context.Context, deletedBefore time.Time) error {
+			return errors.New("any error")
+		})
+
+		err := purgeDeletedFunc(ctx, retention)
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,9 @@
 package statistic
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Create a statistic
@@ -12,6 +15,12 @@ type (
 	// Soft delete a statistic by id and game id
 	StorageSoftDeleteStatistic func(ctx context.Context, id, gameID string) error
 
+	// Restore a soft deleted statistic by id and game id
+	StorageRestoreStatistic func(ctx context.Context, id, gameID string) (Statistic, error)
+
+	// Permanently remove the statistics soft deleted before the time provided, with the progression of their players
+	StoragePurgeDeletedStatistics func(ctx context.Context, deletedBefore time.Time) error
+
 	// Updates the player statistic progression using the provided value
 	StorageUpdatePlayerProgressionFunc func(ctx context.Context, statistic Statistic, playerID string, value float64) (PlayerProgression, PlayerProgressionUpdates, error)
 
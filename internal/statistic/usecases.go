@@ -1,6 +1,9 @@
 package statistic
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Create a statistic
@@ -12,6 +15,12 @@ type (
 	// Soft delete a statistic by id and game id
 	SoftDeleteByIDAndGameIDFunc func(ctx context.Context, id, gameID string) error
 
+	// Restore a soft deleted statistic by id and game id
+	RestoreByIDAndGameIDFunc func(ctx context.Context, id, gameID string) (Statistic, error)
+
+	// Permanently remove the statistics deleted longer than the retention ago, with the progression of their players
+	PurgeDeletedFunc func(ctx context.Context, retention time.Duration) error
+
 	// Update player statistic progression using the provided value
 	UpsertPlayerProgressionFunc func(ctx context.Context, statistic Statistic, playerID string, value float64) error
 